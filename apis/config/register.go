@@ -36,6 +36,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&CoschedulingArgs{},
 		&NodeResourcesAllocatableArgs{},
+		&NodeResourcesStrandedArgs{},
 		&TargetLoadPackingArgs{},
 		&LoadVariationRiskBalancingArgs{},
 		&LowRiskOverCommitmentArgs{},
@@ -44,6 +45,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&TopologicalSortArgs{},
 		&NetworkOverheadArgs{},
 		&SySchedArgs{},
+		&QOSSortArgs{},
+		&PodStateArgs{},
 		&PeaksArgs{},
 	)
 	return nil
@@ -17,13 +17,18 @@ limitations under the License.
 package scheme
 
 import (
+	"k8s.io/apimachinery/pkg/conversion"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	configv1 "k8s.io/kube-scheduler/config/v1"
+	kubeschedulerconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
 	kubeschedulerscheme "k8s.io/kubernetes/pkg/scheduler/apis/config/scheme"
+	kubeschedulerconfigv1 "k8s.io/kubernetes/pkg/scheduler/apis/config/v1"
 
 	"sigs.k8s.io/scheduler-plugins/apis/config"
 	"sigs.k8s.io/scheduler-plugins/apis/config/v1"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
 )
 
 var (
@@ -42,4 +47,18 @@ func init() {
 func AddToScheme(scheme *runtime.Scheme) {
 	utilruntime.Must(config.AddToScheme(scheme))
 	utilruntime.Must(v1.AddToScheme(scheme))
+	// Replaces the in-tree conversion, to validate the args of the plugins once they are converted.
+	utilruntime.Must(scheme.AddConversionFunc((*configv1.KubeSchedulerConfiguration)(nil), (*kubeschedulerconfig.KubeSchedulerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return convertAndValidateKubeSchedulerConfiguration(a.(*configv1.KubeSchedulerConfiguration), b.(*kubeschedulerconfig.KubeSchedulerConfiguration), scope)
+	}))
+}
+
+// convertAndValidateKubeSchedulerConfiguration converts the configuration like the in-tree
+// conversion, then validates the args of the out-of-tree plugins so that a bad configuration fails
+// when it is decoded rather than when the plugins are instantiated.
+func convertAndValidateKubeSchedulerConfiguration(in *configv1.KubeSchedulerConfiguration, out *kubeschedulerconfig.KubeSchedulerConfiguration, scope conversion.Scope) error {
+	if err := kubeschedulerconfigv1.Convert_v1_KubeSchedulerConfiguration_To_config_KubeSchedulerConfiguration(in, out, scope); err != nil {
+		return err
+	}
+	return validation.ValidateProfilesPluginArgs(out.Profiles)
 }
@@ -34,6 +34,8 @@ import (
 	"sigs.k8s.io/scheduler-plugins/pkg/networkaware/networkoverhead"
 	"sigs.k8s.io/scheduler-plugins/pkg/networkaware/topologicalsort"
 	"sigs.k8s.io/scheduler-plugins/pkg/noderesources"
+	"sigs.k8s.io/scheduler-plugins/pkg/podstate"
+	"sigs.k8s.io/scheduler-plugins/pkg/qos"
 	"sigs.k8s.io/scheduler-plugins/pkg/trimaran/loadvariationriskbalancing"
 	"sigs.k8s.io/scheduler-plugins/pkg/trimaran/lowriskovercommitment"
 	"sigs.k8s.io/scheduler-plugins/pkg/trimaran/targetloadpacking"
@@ -65,6 +67,36 @@ profiles:
 `),
 			wantErr: `strict decoding error: decoding .profiles[0].pluginConfig[0]: strict decoding error: decoding args for plugin Coscheduling: strict decoding error: unknown field "kubeConfigPath"`,
 		},
+		{
+			name: "coscheduling plugin args invalid to get validation error",
+			data: []byte(`
+apiVersion: kubescheduler.config.k8s.io/v1
+kind: KubeSchedulerConfiguration
+profiles:
+- schedulerName: scheduler-plugins
+  pluginConfig:
+  - name: Coscheduling
+    args:
+      podGroupBackoffSeconds: -1
+`),
+			wantErr: `profiles[0].pluginConfig[0].args.podGroupBackoffSeconds: Invalid value: -1: must not be negative`,
+		},
+		{
+			name: "qos sort plugin args invalid to get validation error",
+			data: []byte(`
+apiVersion: kubescheduler.config.k8s.io/v1
+kind: KubeSchedulerConfiguration
+profiles:
+- schedulerName: scheduler-plugins
+  pluginConfig:
+  - name: QOSSort
+    args:
+      keys:
+      - type: Priority
+      - type: PodLabel
+`),
+			wantErr: `profiles[0].pluginConfig[0].args.keys[1].labelKey: Required value: labelKey is required by the PodLabel queue sort key`,
+		},
 		{
 			name: "v1 all plugin args in default profile",
 			data: []byte(`
@@ -77,13 +109,25 @@ profiles:
   - name: TopologicalSort
     args:
       namespaces:
-      - "networkAware"
+      - "network-aware"
   - name: NetworkOverhead
     args:
       namespaces:
-      - "networkAware"
+      - "network-aware"
       weightsName: "netCosts"
       networkTopologyName: "net-topology-v1"
+  - name: QOSSort
+    args:
+      keys:
+      - type: Priority
+      - type: RequestedSize
+      - type: PodLabel
+        labelKey: tier
+        reverse: true
+  - name: PodState
+    args:
+      recentlyBoundWeight: 2
+      scoringMode: Resources
 `),
 			wantProfiles: []schedconfig.KubeSchedulerProfile{
 				{
@@ -99,17 +143,37 @@ profiles:
 						{
 							Name: topologicalsort.Name,
 							Args: &config.TopologicalSortArgs{
-								Namespaces: []string{"networkAware"},
+								Namespaces: []string{"network-aware"},
 							},
 						},
 						{
 							Name: networkoverhead.Name,
 							Args: &config.NetworkOverheadArgs{
-								Namespaces:          []string{"networkAware"},
+								Namespaces:          []string{"network-aware"},
 								WeightsName:         "netCosts",
 								NetworkTopologyName: "net-topology-v1",
 							},
 						},
+						{
+							Name: qos.Name,
+							Args: &config.QOSSortArgs{
+								Keys: []config.QueueSortKey{
+									{Type: config.QueueSortKeyPriority},
+									{Type: config.QueueSortKeyRequestedSize, Resources: []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}},
+									{Type: config.QueueSortKeyPodLabel, LabelKey: "tier", Reverse: true},
+								},
+							},
+						},
+						{
+							Name: podstate.Name,
+							Args: &config.PodStateArgs{
+								TerminatingWeight:   1,
+								NominatedWeight:     1,
+								RecentlyBoundWeight: 2,
+								ScoringMode:         config.PodStateScoringResources,
+								Resources:           []schedconfig.ResourceSpec{{Name: "cpu", Weight: 1}, {Name: "memory", Weight: 1}},
+							},
+						},
 						{
 							Name: "DefaultPreemption",
 							Args: &schedconfig.DefaultPreemptionArgs{MinCandidateNodesPercentage: 10, MinCandidateNodesAbsolute: 100},
@@ -368,6 +432,7 @@ profiles:
     name: Coscheduling
   - args:
       apiVersion: kubescheduler.config.k8s.io/v1
+      extendedResourceWeight: 0
       kind: NodeResourcesAllocatableArgs
       mode: Least
       resources:
@@ -32,6 +32,9 @@ type CoschedulingArgs struct {
 	PermitWaitingTimeSeconds int64
 	// PodGroupBackoffSeconds is the backoff time in seconds before a pod group can be scheduled again.
 	PodGroupBackoffSeconds int64
+	// NUMAAwareAdmission enables the check that the members of a PodGroup can be placed each
+	// on a distinct NUMA zone, using the NodeResourceTopology data, before permitting the PodGroup.
+	NUMAAwareAdmission bool
 }
 
 // ModeType is a "string" type.
@@ -42,6 +45,8 @@ const (
 	Least ModeType = "Least"
 	// Most is the string "Most".
 	Most ModeType = "Most"
+	// RatioMatching is the string "RatioMatching".
+	RatioMatching ModeType = "RatioMatching"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -57,8 +62,39 @@ type NodeResourcesAllocatableArgs struct {
 	// weight as 1 millicore.
 	Resources []schedconfig.ResourceSpec `json:"resources,omitempty"`
 
-	// Whether to prioritize nodes with least or most allocatable resources.
+	// Whether to prioritize nodes with least or most allocatable resources, or the nodes
+	// whose allocatable resources best match the ratio of the resources requested by the pod.
 	Mode ModeType `json:"mode,omitempty"`
+
+	// ExtendedResourceWeight is the weight of the extended resources requested by the pod,
+	// e.g. GPUs, which are not listed in Resources. Zero ignores them.
+	ExtendedResourceWeight int64 `json:"extendedResourceWeight,omitempty"`
+
+	// SubtractRequested scores the resources left on the node once the pod is placed,
+	// the allocatable minus the requested resources, rather than the allocatable resources.
+	SubtractRequested bool `json:"subtractRequested,omitempty"`
+}
+
+// PodShape is a typical pod shape, used to estimate the capacity of a node which is stranded.
+type PodShape struct {
+	// Name of the shape, for reference.
+	Name string
+	// Requests of the shape.
+	Requests v1.ResourceList
+	// Weight of the shape, e.g. how frequent the shape is among the workloads.
+	Weight int64
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodeResourcesStrandedArgs holds arguments used to configure NodeResourcesStranded plugin.
+type NodeResourcesStrandedArgs struct {
+	metav1.TypeMeta
+
+	// Resources to be considered when scoring, with their weights.
+	Resources []schedconfig.ResourceSpec
+	// PodShapes is the catalogue of typical pod shapes the capacity left on a node is estimated against.
+	PodShapes []PodShape
 }
 
 // MetricProviderType is a "string" type.
@@ -156,6 +192,12 @@ type ScoringStrategy struct {
 	// Resources a list of pairs <resource, weight> to be considered while scoring
 	// allowed weights start from 1.
 	Resources []schedconfig.ResourceSpec
+
+	// DistanceWeighted, if true, scales the score computed by the MostAllocated, LeastAllocated
+	// and BalancedAllocation strategies by the distance between the NUMA zones the pod is expected
+	// to span, so nodes able to place the pod on the closest zones are preferred.
+	// Has no effect on the LeastNUMANodes strategy, which always considers distances.
+	DistanceWeighted bool
 }
 
 // ForeignPodsDetectMode is a "string" type.
@@ -192,6 +234,14 @@ const (
 	CacheResyncScopeOnlyResources CacheResyncScope = "OnlyResources"
 )
 
+// CacheResyncTrigger is a "string" type
+type CacheResyncTrigger string
+
+const (
+	CacheResyncTriggerPeriodic CacheResyncTrigger = "Periodic"
+	CacheResyncTriggerEvent    CacheResyncTrigger = "Event"
+)
+
 // NodeResourceTopologyCache define configuration details for the NodeResourceTopology cache.
 type NodeResourceTopologyCache struct {
 	// ForeignPodsDetect sets how foreign pods should be handled.
@@ -219,6 +269,14 @@ type NodeResourceTopologyCache struct {
 	// "All" to make the code react to node config changes avoiding reboots.
 	// Use "OnlyResources" to restore the previous behavior.
 	ResyncScope *CacheResyncScope
+	// ResyncTrigger controls when the resync of the dirty nodes is attempted.
+	// "Periodic" attempts the resync every CacheResyncPeriodSeconds, fetching the latest
+	// NRT data of the dirty nodes. "Event" additionally attempts the resync of a dirty node as soon
+	// as updated NRT data for it is observed, so the node becomes usable again without waiting
+	// for the next period, which still acts as fallback.
+	// Has no effect if caching is disabled (CacheResyncPeriod is zero) or if DiscardReservedNodes
+	// is enabled. If unspecified, default is "Periodic".
+	ResyncTrigger *CacheResyncTrigger
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -278,6 +336,104 @@ type SySchedArgs struct {
 
 	// CR name of the default profile for all system calls
 	DefaultProfileName string
+
+	// Weights of the critical system calls, e.g. the ones with known CVEs, used
+	// to compute the extraneous system call exposure. The system calls not listed weigh 1.
+	SyscallWeights map[string]int64
+
+	// Namespace of the ConfigMap holding the system call risk catalogue.
+	// If empty, DefaultProfileNamespace is used.
+	RiskCatalogueNamespace string
+
+	// Name of the ConfigMap holding the system call risk catalogue. Each key of the ConfigMap
+	// data is a system call name and its value is the weight of the system call, overriding
+	// the one in SyscallWeights. If empty, only SyscallWeights is used.
+	RiskCatalogueName string
+
+	// Maximum extraneous system call exposure, weighted as in the score, the incoming pod and the pods
+	// already running on a node may see if the incoming pod lands on the node. Nodes exceeding it are
+	// filtered out when the Filter extension point of the plugin is enabled. If nil, there is no limit.
+	MaxExtraneousSyscalls *int64
+
+	// If set to true, nodes where the incoming pod would run alongside an unconfined pod, that is a pod
+	// without a seccomp profile which gets the default profile, are filtered out when the Filter
+	// extension point of the plugin is enabled.
+	RejectUnconfinedColocation bool
+}
+
+// QueueSortKeyType is a "string" type.
+type QueueSortKeyType string
+
+const (
+	// QueueSortKeyPriority orders the pods by decreasing priority.
+	QueueSortKeyPriority QueueSortKeyType = "Priority"
+	// QueueSortKeyQOSClass orders the pods by QoS class: Guaranteed, Burstable, then BestEffort.
+	QueueSortKeyQOSClass QueueSortKeyType = "QOSClass"
+	// QueueSortKeyRequestedSize orders the pods by increasing requests of the key Resources,
+	// compared one after the other.
+	QueueSortKeyRequestedSize QueueSortKeyType = "RequestedSize"
+	// QueueSortKeyNamespaceWeight orders the pods by decreasing weight of their namespace
+	// in the key NamespaceWeights.
+	QueueSortKeyNamespaceWeight QueueSortKeyType = "NamespaceWeight"
+	// QueueSortKeyPodLabel orders the pods by decreasing value of their label with the key LabelKey.
+	// Values are compared as integers if both are, as strings otherwise. Pods without the label come last.
+	QueueSortKeyPodLabel QueueSortKeyType = "PodLabel"
+	// QueueSortKeyCreationTime orders the pods by increasing creation time.
+	QueueSortKeyCreationTime QueueSortKeyType = "CreationTime"
+)
+
+// QueueSortKey defines a key used to order the pods in the scheduling queue.
+type QueueSortKey struct {
+	// Type of the key.
+	Type QueueSortKeyType
+	// Reverse reverses the order of the key.
+	Reverse bool
+	// Resources compared by the RequestedSize key, in order.
+	Resources []v1.ResourceName
+	// NamespaceWeights used by the NamespaceWeight key. Namespaces not listed weigh 0.
+	NamespaceWeights map[string]int64
+	// LabelKey is the key of the label used by the PodLabel key.
+	LabelKey string
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QOSSortArgs holds arguments used to configure the QOSSort plugin.
+type QOSSortArgs struct {
+	metav1.TypeMeta
+
+	// Keys used to order the pods, tried in order until one tells the pods apart.
+	// Pods which are still tied are ordered by the time they were added to the queue.
+	Keys []QueueSortKey
+}
+
+// PodStateScoringMode is a "string" type.
+type PodStateScoringMode string
+
+const (
+	// PodStateScoringPodCount weighs each pod as one.
+	PodStateScoringPodCount PodStateScoringMode = "PodCount"
+	// PodStateScoringResources weighs each pod by the fraction of the node allocatable it requests.
+	PodStateScoringResources PodStateScoringMode = "Resources"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PodStateArgs holds arguments used to configure the PodState plugin.
+type PodStateArgs struct {
+	metav1.TypeMeta
+
+	// TerminatingWeight is the weight of the pods being terminated on a node, which favor the node.
+	TerminatingWeight int64
+	// NominatedWeight is the weight of the pods nominated to a node, which disfavor the node.
+	NominatedWeight int64
+	// RecentlyBoundWeight is the weight of the pods bound to a node but not started yet,
+	// which disfavor the node.
+	RecentlyBoundWeight int64
+	// ScoringMode tells whether the pods are counted or weighted by the resources they request.
+	ScoringMode PodStateScoringMode
+	// Resources considered by the Resources scoring mode, with their weights.
+	Resources []schedconfig.ResourceSpec
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
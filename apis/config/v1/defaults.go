@@ -32,6 +32,31 @@ var (
 
 	defaultNodeResourcesAllocatableMode = Least
 
+	// defaultNodeResourcesAllocatableExtendedResourceWeight is the weight of the extended resources
+	// not listed in the NodeResourcesAllocatable resources. With the default weights, a device
+	// weighs as much as a core.
+	defaultNodeResourcesAllocatableExtendedResourceWeight int64 = 1 << 30
+
+	// defaultNodeResourcesStrandedPodShapes is the catalogue of typical pod shapes used by
+	// the NodeResourcesStranded scoring plugin.
+	defaultNodeResourcesStrandedPodShapes = []PodShape{
+		{
+			Name:     "small",
+			Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m"), v1.ResourceMemory: resource.MustParse("1Gi")},
+			Weight:   1,
+		},
+		{
+			Name:     "medium",
+			Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2"), v1.ResourceMemory: resource.MustParse("4Gi")},
+			Weight:   1,
+		},
+		{
+			Name:     "large",
+			Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("4"), v1.ResourceMemory: resource.MustParse("16Gi")},
+			Weight:   1,
+		},
+	}
+
 	// defaultResourcesToWeightMap is used to set the default resourceToWeight map for CPU and memory
 	// used by the NodeResourcesAllocatable scoring plugin.
 	// The base unit for CPU is millicore, while the base using for memory is a byte.
@@ -102,6 +127,22 @@ var (
 	DefaultSySchedProfileNamespace = "default"
 	// DefaultSySchedProfileName is the name of the default syscall profile CR for SySched plugin
 	DefaultSySchedProfileName = "all-syscalls"
+
+	// Defaults for QOSSort
+	// DefaultQueueSortKeys orders the pods by priority, then by QoS class
+	DefaultQueueSortKeys = []QueueSortKey{{Type: QueueSortKeyPriority}, {Type: QueueSortKeyQOSClass}}
+	// DefaultQueueSortResources are the resources compared by the RequestedSize key
+	DefaultQueueSortResources = []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory}
+
+	// Defaults for PodState
+	// DefaultPodStateTerminatingWeight is the weight of the terminating pods
+	DefaultPodStateTerminatingWeight int64 = 1
+	// DefaultPodStateNominatedWeight is the weight of the nominated pods
+	DefaultPodStateNominatedWeight int64 = 1
+	// DefaultPodStateRecentlyBoundWeight is the weight of the pods bound but not started yet
+	DefaultPodStateRecentlyBoundWeight int64 = 0
+	// DefaultPodStateScoringMode counts the pods
+	DefaultPodStateScoringMode = PodStateScoringPodCount
 )
 
 // SetDefaults_CoschedulingArgs sets the default parameters for Coscheduling plugin.
@@ -123,6 +164,29 @@ func SetDefaults_NodeResourcesAllocatableArgs(obj *NodeResourcesAllocatableArgs)
 	if obj.Mode == "" {
 		obj.Mode = defaultNodeResourcesAllocatableMode
 	}
+
+	if obj.ExtendedResourceWeight == nil {
+		obj.ExtendedResourceWeight = &defaultNodeResourcesAllocatableExtendedResourceWeight
+	}
+}
+
+// SetDefaults_NodeResourcesStrandedArgs sets the defaults parameters for NodeResourcesStranded.
+func SetDefaults_NodeResourcesStrandedArgs(obj *NodeResourcesStrandedArgs) {
+	if len(obj.Resources) == 0 {
+		obj.Resources = append([]schedulerconfigv1.ResourceSpec{}, defaultResourceSpec...)
+	}
+
+	if len(obj.PodShapes) == 0 {
+		for _, shape := range defaultNodeResourcesStrandedPodShapes {
+			obj.PodShapes = append(obj.PodShapes, *shape.DeepCopy())
+		}
+	}
+
+	for i := range obj.PodShapes {
+		if obj.PodShapes[i].Weight == 0 {
+			obj.PodShapes[i].Weight = 1
+		}
+	}
 }
 
 // SetDefaultTrimaranSpec sets the default parameters for common Trimaran plugins
@@ -250,3 +314,39 @@ func SetDefaults_SySchedArgs(obj *SySchedArgs) {
 		obj.DefaultProfileName = &DefaultSySchedProfileName
 	}
 }
+
+// SetDefaults_QOSSortArgs sets the default parameters for QOSSort plugin.
+func SetDefaults_QOSSortArgs(obj *QOSSortArgs) {
+	if len(obj.Keys) == 0 {
+		obj.Keys = append([]QueueSortKey{}, DefaultQueueSortKeys...)
+	}
+
+	for i := range obj.Keys {
+		if obj.Keys[i].Type == QueueSortKeyRequestedSize && len(obj.Keys[i].Resources) == 0 {
+			obj.Keys[i].Resources = append([]v1.ResourceName{}, DefaultQueueSortResources...)
+		}
+	}
+}
+
+// SetDefaults_PodStateArgs sets the default parameters for PodState plugin.
+func SetDefaults_PodStateArgs(obj *PodStateArgs) {
+	if obj.TerminatingWeight == nil {
+		obj.TerminatingWeight = &DefaultPodStateTerminatingWeight
+	}
+
+	if obj.NominatedWeight == nil {
+		obj.NominatedWeight = &DefaultPodStateNominatedWeight
+	}
+
+	if obj.RecentlyBoundWeight == nil {
+		obj.RecentlyBoundWeight = &DefaultPodStateRecentlyBoundWeight
+	}
+
+	if obj.ScoringMode == "" {
+		obj.ScoringMode = DefaultPodStateScoringMode
+	}
+
+	if len(obj.Resources) == 0 {
+		obj.Resources = append([]schedulerconfigv1.ResourceSpec{}, defaultResourceSpec...)
+	}
+}
@@ -61,7 +61,8 @@ func TestSchedulingDefaults(t *testing.T) {
 				Resources: []schedulerconfigv1.ResourceSpec{
 					{Name: "cpu", Weight: 1 << 20}, {Name: "memory", Weight: 1},
 				},
-				Mode: Least,
+				Mode:                   Least,
+				ExtendedResourceWeight: pointer.Int64Ptr(1 << 30),
 			},
 		},
 		{
@@ -76,7 +77,68 @@ func TestSchedulingDefaults(t *testing.T) {
 				Resources: []schedulerconfigv1.ResourceSpec{
 					{Name: "cpu", Weight: 1 << 10}, {Name: "memory", Weight: 2},
 				},
-				Mode: Most,
+				Mode:                   Most,
+				ExtendedResourceWeight: pointer.Int64Ptr(1 << 30),
+			},
+		},
+		{
+			name: "set RatioMatching NodeResourcesAllocatableArgs subtracting requested resources",
+			config: &NodeResourcesAllocatableArgs{
+				Resources: []schedulerconfigv1.ResourceSpec{
+					{Name: "cpu", Weight: 1 << 10}, {Name: "memory", Weight: 2},
+				},
+				Mode:                   RatioMatching,
+				ExtendedResourceWeight: pointer.Int64Ptr(0),
+				SubtractRequested:      true,
+			},
+			expect: &NodeResourcesAllocatableArgs{
+				Resources: []schedulerconfigv1.ResourceSpec{
+					{Name: "cpu", Weight: 1 << 10}, {Name: "memory", Weight: 2},
+				},
+				Mode:                   RatioMatching,
+				ExtendedResourceWeight: pointer.Int64Ptr(0),
+				SubtractRequested:      true,
+			},
+		},
+		{
+			name:   "empty config NodeResourcesStrandedArgs",
+			config: &NodeResourcesStrandedArgs{},
+			expect: &NodeResourcesStrandedArgs{
+				Resources: []schedulerconfigv1.ResourceSpec{
+					{Name: "cpu", Weight: 1}, {Name: "memory", Weight: 1},
+				},
+				PodShapes: []PodShape{
+					{
+						Name:     "small",
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m"), v1.ResourceMemory: resource.MustParse("1Gi")},
+						Weight:   1,
+					},
+					{
+						Name:     "medium",
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2"), v1.ResourceMemory: resource.MustParse("4Gi")},
+						Weight:   1,
+					},
+					{
+						Name:     "large",
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("4"), v1.ResourceMemory: resource.MustParse("16Gi")},
+						Weight:   1,
+					},
+				},
+			},
+		},
+		{
+			name: "set non default NodeResourcesStrandedArgs",
+			config: &NodeResourcesStrandedArgs{
+				Resources: []schedulerconfigv1.ResourceSpec{{Name: "cpu", Weight: 2}},
+				PodShapes: []PodShape{
+					{Name: "cpu", Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")}},
+				},
+			},
+			expect: &NodeResourcesStrandedArgs{
+				Resources: []schedulerconfigv1.ResourceSpec{{Name: "cpu", Weight: 2}},
+				PodShapes: []PodShape{
+					{Name: "cpu", Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")}, Weight: 1},
+				},
 			},
 		},
 		{
@@ -263,6 +325,54 @@ func TestSchedulingDefaults(t *testing.T) {
 				DefaultProfileName:      pointer.StringPtr("all-syscalls"),
 			},
 		},
+		{
+			name:   "empty config QOSSortArgs",
+			config: &QOSSortArgs{},
+			expect: &QOSSortArgs{
+				Keys: []QueueSortKey{{Type: QueueSortKeyPriority}, {Type: QueueSortKeyQOSClass}},
+			},
+		},
+		{
+			name: "set non default QOSSortArgs",
+			config: &QOSSortArgs{
+				Keys: []QueueSortKey{{Type: QueueSortKeyRequestedSize}, {Type: QueueSortKeyCreationTime}},
+			},
+			expect: &QOSSortArgs{
+				Keys: []QueueSortKey{
+					{Type: QueueSortKeyRequestedSize, Resources: []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory}},
+					{Type: QueueSortKeyCreationTime},
+				},
+			},
+		},
+		{
+			name:   "empty config PodStateArgs",
+			config: &PodStateArgs{},
+			expect: &PodStateArgs{
+				TerminatingWeight:   pointer.Int64Ptr(1),
+				NominatedWeight:     pointer.Int64Ptr(1),
+				RecentlyBoundWeight: pointer.Int64Ptr(0),
+				ScoringMode:         PodStateScoringPodCount,
+				Resources: []schedulerconfigv1.ResourceSpec{
+					{Name: "cpu", Weight: 1},
+					{Name: "memory", Weight: 1},
+				},
+			},
+		},
+		{
+			name: "set non default PodStateArgs",
+			config: &PodStateArgs{
+				NominatedWeight: pointer.Int64Ptr(3),
+				ScoringMode:     PodStateScoringResources,
+				Resources:       []schedulerconfigv1.ResourceSpec{{Name: "cpu", Weight: 2}},
+			},
+			expect: &PodStateArgs{
+				TerminatingWeight:   pointer.Int64Ptr(1),
+				NominatedWeight:     pointer.Int64Ptr(3),
+				RecentlyBoundWeight: pointer.Int64Ptr(0),
+				ScoringMode:         PodStateScoringResources,
+				Resources:           []schedulerconfigv1.ResourceSpec{{Name: "cpu", Weight: 2}},
+			},
+		},
 		{
 			name: "set non default SySchedArgs",
 			config: &SySchedArgs{
@@ -110,6 +110,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeResourcesStrandedArgs)(nil), (*config.NodeResourcesStrandedArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NodeResourcesStrandedArgs_To_config_NodeResourcesStrandedArgs(a.(*NodeResourcesStrandedArgs), b.(*config.NodeResourcesStrandedArgs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NodeResourcesStrandedArgs)(nil), (*NodeResourcesStrandedArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NodeResourcesStrandedArgs_To_v1_NodeResourcesStrandedArgs(a.(*config.NodeResourcesStrandedArgs), b.(*NodeResourcesStrandedArgs), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PeaksArgs)(nil), (*config.PeaksArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_PeaksArgs_To_config_PeaksArgs(a.(*PeaksArgs), b.(*config.PeaksArgs), scope)
 	}); err != nil {
@@ -120,6 +130,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PodShape)(nil), (*config.PodShape)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PodShape_To_config_PodShape(a.(*PodShape), b.(*config.PodShape), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PodShape)(nil), (*PodShape)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PodShape_To_v1_PodShape(a.(*config.PodShape), b.(*PodShape), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PodStateArgs)(nil), (*config.PodStateArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PodStateArgs_To_config_PodStateArgs(a.(*PodStateArgs), b.(*config.PodStateArgs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PodStateArgs)(nil), (*PodStateArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PodStateArgs_To_v1_PodStateArgs(a.(*config.PodStateArgs), b.(*PodStateArgs), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PowerModel)(nil), (*config.PowerModel)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_PowerModel_To_config_PowerModel(a.(*PowerModel), b.(*config.PowerModel), scope)
 	}); err != nil {
@@ -140,6 +170,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QOSSortArgs)(nil), (*config.QOSSortArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_QOSSortArgs_To_config_QOSSortArgs(a.(*QOSSortArgs), b.(*config.QOSSortArgs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.QOSSortArgs)(nil), (*QOSSortArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_QOSSortArgs_To_v1_QOSSortArgs(a.(*config.QOSSortArgs), b.(*QOSSortArgs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QueueSortKey)(nil), (*config.QueueSortKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_QueueSortKey_To_config_QueueSortKey(a.(*QueueSortKey), b.(*config.QueueSortKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.QueueSortKey)(nil), (*QueueSortKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_QueueSortKey_To_v1_QueueSortKey(a.(*config.QueueSortKey), b.(*QueueSortKey), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ScoringStrategy)(nil), (*config.ScoringStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ScoringStrategy_To_config_ScoringStrategy(a.(*ScoringStrategy), b.(*config.ScoringStrategy), scope)
 	}); err != nil {
@@ -210,6 +260,7 @@ func autoConvert_v1_CoschedulingArgs_To_config_CoschedulingArgs(in *Coscheduling
 	if err := metav1.Convert_Pointer_int64_To_int64(&in.PodGroupBackoffSeconds, &out.PodGroupBackoffSeconds, s); err != nil {
 		return err
 	}
+	out.NUMAAwareAdmission = in.NUMAAwareAdmission
 	return nil
 }
 
@@ -225,6 +276,7 @@ func autoConvert_config_CoschedulingArgs_To_v1_CoschedulingArgs(in *config.Cosch
 	if err := metav1.Convert_int64_To_Pointer_int64(&in.PodGroupBackoffSeconds, &out.PodGroupBackoffSeconds, s); err != nil {
 		return err
 	}
+	out.NUMAAwareAdmission = in.NUMAAwareAdmission
 	return nil
 }
 
@@ -376,6 +428,7 @@ func autoConvert_v1_NodeResourceTopologyCache_To_config_NodeResourceTopologyCach
 	out.ResyncMethod = (*config.CacheResyncMethod)(unsafe.Pointer(in.ResyncMethod))
 	out.InformerMode = (*config.CacheInformerMode)(unsafe.Pointer(in.InformerMode))
 	out.ResyncScope = (*config.CacheResyncScope)(unsafe.Pointer(in.ResyncScope))
+	out.ResyncTrigger = (*config.CacheResyncTrigger)(unsafe.Pointer(in.ResyncTrigger))
 	return nil
 }
 
@@ -389,6 +442,7 @@ func autoConvert_config_NodeResourceTopologyCache_To_v1_NodeResourceTopologyCach
 	out.ResyncMethod = (*CacheResyncMethod)(unsafe.Pointer(in.ResyncMethod))
 	out.InformerMode = (*CacheInformerMode)(unsafe.Pointer(in.InformerMode))
 	out.ResyncScope = (*CacheResyncScope)(unsafe.Pointer(in.ResyncScope))
+	out.ResyncTrigger = (*CacheResyncTrigger)(unsafe.Pointer(in.ResyncTrigger))
 	return nil
 }
 
@@ -420,6 +474,10 @@ func autoConvert_config_NodeResourceTopologyMatchArgs_To_v1_NodeResourceTopology
 func autoConvert_v1_NodeResourcesAllocatableArgs_To_config_NodeResourcesAllocatableArgs(in *NodeResourcesAllocatableArgs, out *config.NodeResourcesAllocatableArgs, s conversion.Scope) error {
 	out.Resources = *(*[]apisconfig.ResourceSpec)(unsafe.Pointer(&in.Resources))
 	out.Mode = config.ModeType(in.Mode)
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.ExtendedResourceWeight, &out.ExtendedResourceWeight, s); err != nil {
+		return err
+	}
+	out.SubtractRequested = in.SubtractRequested
 	return nil
 }
 
@@ -431,6 +489,10 @@ func Convert_v1_NodeResourcesAllocatableArgs_To_config_NodeResourcesAllocatableA
 func autoConvert_config_NodeResourcesAllocatableArgs_To_v1_NodeResourcesAllocatableArgs(in *config.NodeResourcesAllocatableArgs, out *NodeResourcesAllocatableArgs, s conversion.Scope) error {
 	out.Resources = *(*[]configv1.ResourceSpec)(unsafe.Pointer(&in.Resources))
 	out.Mode = ModeType(in.Mode)
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.ExtendedResourceWeight, &out.ExtendedResourceWeight, s); err != nil {
+		return err
+	}
+	out.SubtractRequested = in.SubtractRequested
 	return nil
 }
 
@@ -439,6 +501,28 @@ func Convert_config_NodeResourcesAllocatableArgs_To_v1_NodeResourcesAllocatableA
 	return autoConvert_config_NodeResourcesAllocatableArgs_To_v1_NodeResourcesAllocatableArgs(in, out, s)
 }
 
+func autoConvert_v1_NodeResourcesStrandedArgs_To_config_NodeResourcesStrandedArgs(in *NodeResourcesStrandedArgs, out *config.NodeResourcesStrandedArgs, s conversion.Scope) error {
+	out.Resources = *(*[]apisconfig.ResourceSpec)(unsafe.Pointer(&in.Resources))
+	out.PodShapes = *(*[]config.PodShape)(unsafe.Pointer(&in.PodShapes))
+	return nil
+}
+
+// Convert_v1_NodeResourcesStrandedArgs_To_config_NodeResourcesStrandedArgs is an autogenerated conversion function.
+func Convert_v1_NodeResourcesStrandedArgs_To_config_NodeResourcesStrandedArgs(in *NodeResourcesStrandedArgs, out *config.NodeResourcesStrandedArgs, s conversion.Scope) error {
+	return autoConvert_v1_NodeResourcesStrandedArgs_To_config_NodeResourcesStrandedArgs(in, out, s)
+}
+
+func autoConvert_config_NodeResourcesStrandedArgs_To_v1_NodeResourcesStrandedArgs(in *config.NodeResourcesStrandedArgs, out *NodeResourcesStrandedArgs, s conversion.Scope) error {
+	out.Resources = *(*[]configv1.ResourceSpec)(unsafe.Pointer(&in.Resources))
+	out.PodShapes = *(*[]PodShape)(unsafe.Pointer(&in.PodShapes))
+	return nil
+}
+
+// Convert_config_NodeResourcesStrandedArgs_To_v1_NodeResourcesStrandedArgs is an autogenerated conversion function.
+func Convert_config_NodeResourcesStrandedArgs_To_v1_NodeResourcesStrandedArgs(in *config.NodeResourcesStrandedArgs, out *NodeResourcesStrandedArgs, s conversion.Scope) error {
+	return autoConvert_config_NodeResourcesStrandedArgs_To_v1_NodeResourcesStrandedArgs(in, out, s)
+}
+
 func autoConvert_v1_PeaksArgs_To_config_PeaksArgs(in *PeaksArgs, out *config.PeaksArgs, s conversion.Scope) error {
 	out.WatcherAddress = in.WatcherAddress
 	out.NodePowerModel = *(*map[string]config.PowerModel)(unsafe.Pointer(&in.NodePowerModel))
@@ -461,6 +545,70 @@ func Convert_config_PeaksArgs_To_v1_PeaksArgs(in *config.PeaksArgs, out *PeaksAr
 	return autoConvert_config_PeaksArgs_To_v1_PeaksArgs(in, out, s)
 }
 
+func autoConvert_v1_PodShape_To_config_PodShape(in *PodShape, out *config.PodShape, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Requests = *(*corev1.ResourceList)(unsafe.Pointer(&in.Requests))
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1_PodShape_To_config_PodShape is an autogenerated conversion function.
+func Convert_v1_PodShape_To_config_PodShape(in *PodShape, out *config.PodShape, s conversion.Scope) error {
+	return autoConvert_v1_PodShape_To_config_PodShape(in, out, s)
+}
+
+func autoConvert_config_PodShape_To_v1_PodShape(in *config.PodShape, out *PodShape, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Requests = *(*corev1.ResourceList)(unsafe.Pointer(&in.Requests))
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_config_PodShape_To_v1_PodShape is an autogenerated conversion function.
+func Convert_config_PodShape_To_v1_PodShape(in *config.PodShape, out *PodShape, s conversion.Scope) error {
+	return autoConvert_config_PodShape_To_v1_PodShape(in, out, s)
+}
+
+func autoConvert_v1_PodStateArgs_To_config_PodStateArgs(in *PodStateArgs, out *config.PodStateArgs, s conversion.Scope) error {
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.TerminatingWeight, &out.TerminatingWeight, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.NominatedWeight, &out.NominatedWeight, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.RecentlyBoundWeight, &out.RecentlyBoundWeight, s); err != nil {
+		return err
+	}
+	out.ScoringMode = config.PodStateScoringMode(in.ScoringMode)
+	out.Resources = *(*[]apisconfig.ResourceSpec)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_v1_PodStateArgs_To_config_PodStateArgs is an autogenerated conversion function.
+func Convert_v1_PodStateArgs_To_config_PodStateArgs(in *PodStateArgs, out *config.PodStateArgs, s conversion.Scope) error {
+	return autoConvert_v1_PodStateArgs_To_config_PodStateArgs(in, out, s)
+}
+
+func autoConvert_config_PodStateArgs_To_v1_PodStateArgs(in *config.PodStateArgs, out *PodStateArgs, s conversion.Scope) error {
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.TerminatingWeight, &out.TerminatingWeight, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.NominatedWeight, &out.NominatedWeight, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.RecentlyBoundWeight, &out.RecentlyBoundWeight, s); err != nil {
+		return err
+	}
+	out.ScoringMode = PodStateScoringMode(in.ScoringMode)
+	out.Resources = *(*[]configv1.ResourceSpec)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_config_PodStateArgs_To_v1_PodStateArgs is an autogenerated conversion function.
+func Convert_config_PodStateArgs_To_v1_PodStateArgs(in *config.PodStateArgs, out *PodStateArgs, s conversion.Scope) error {
+	return autoConvert_config_PodStateArgs_To_v1_PodStateArgs(in, out, s)
+}
+
 func autoConvert_v1_PowerModel_To_config_PowerModel(in *PowerModel, out *config.PowerModel, s conversion.Scope) error {
 	out.K0 = in.K0
 	out.K1 = in.K1
@@ -515,9 +663,58 @@ func Convert_config_PreemptionTolerationArgs_To_v1_PreemptionTolerationArgs(in *
 	return autoConvert_config_PreemptionTolerationArgs_To_v1_PreemptionTolerationArgs(in, out, s)
 }
 
+func autoConvert_v1_QOSSortArgs_To_config_QOSSortArgs(in *QOSSortArgs, out *config.QOSSortArgs, s conversion.Scope) error {
+	out.Keys = *(*[]config.QueueSortKey)(unsafe.Pointer(&in.Keys))
+	return nil
+}
+
+// Convert_v1_QOSSortArgs_To_config_QOSSortArgs is an autogenerated conversion function.
+func Convert_v1_QOSSortArgs_To_config_QOSSortArgs(in *QOSSortArgs, out *config.QOSSortArgs, s conversion.Scope) error {
+	return autoConvert_v1_QOSSortArgs_To_config_QOSSortArgs(in, out, s)
+}
+
+func autoConvert_config_QOSSortArgs_To_v1_QOSSortArgs(in *config.QOSSortArgs, out *QOSSortArgs, s conversion.Scope) error {
+	out.Keys = *(*[]QueueSortKey)(unsafe.Pointer(&in.Keys))
+	return nil
+}
+
+// Convert_config_QOSSortArgs_To_v1_QOSSortArgs is an autogenerated conversion function.
+func Convert_config_QOSSortArgs_To_v1_QOSSortArgs(in *config.QOSSortArgs, out *QOSSortArgs, s conversion.Scope) error {
+	return autoConvert_config_QOSSortArgs_To_v1_QOSSortArgs(in, out, s)
+}
+
+func autoConvert_v1_QueueSortKey_To_config_QueueSortKey(in *QueueSortKey, out *config.QueueSortKey, s conversion.Scope) error {
+	out.Type = config.QueueSortKeyType(in.Type)
+	out.Reverse = in.Reverse
+	out.Resources = *(*[]corev1.ResourceName)(unsafe.Pointer(&in.Resources))
+	out.NamespaceWeights = *(*map[string]int64)(unsafe.Pointer(&in.NamespaceWeights))
+	out.LabelKey = in.LabelKey
+	return nil
+}
+
+// Convert_v1_QueueSortKey_To_config_QueueSortKey is an autogenerated conversion function.
+func Convert_v1_QueueSortKey_To_config_QueueSortKey(in *QueueSortKey, out *config.QueueSortKey, s conversion.Scope) error {
+	return autoConvert_v1_QueueSortKey_To_config_QueueSortKey(in, out, s)
+}
+
+func autoConvert_config_QueueSortKey_To_v1_QueueSortKey(in *config.QueueSortKey, out *QueueSortKey, s conversion.Scope) error {
+	out.Type = QueueSortKeyType(in.Type)
+	out.Reverse = in.Reverse
+	out.Resources = *(*[]corev1.ResourceName)(unsafe.Pointer(&in.Resources))
+	out.NamespaceWeights = *(*map[string]int64)(unsafe.Pointer(&in.NamespaceWeights))
+	out.LabelKey = in.LabelKey
+	return nil
+}
+
+// Convert_config_QueueSortKey_To_v1_QueueSortKey is an autogenerated conversion function.
+func Convert_config_QueueSortKey_To_v1_QueueSortKey(in *config.QueueSortKey, out *QueueSortKey, s conversion.Scope) error {
+	return autoConvert_config_QueueSortKey_To_v1_QueueSortKey(in, out, s)
+}
+
 func autoConvert_v1_ScoringStrategy_To_config_ScoringStrategy(in *ScoringStrategy, out *config.ScoringStrategy, s conversion.Scope) error {
 	out.Type = config.ScoringStrategyType(in.Type)
 	out.Resources = *(*[]apisconfig.ResourceSpec)(unsafe.Pointer(&in.Resources))
+	out.DistanceWeighted = in.DistanceWeighted
 	return nil
 }
 
@@ -529,6 +726,7 @@ func Convert_v1_ScoringStrategy_To_config_ScoringStrategy(in *ScoringStrategy, o
 func autoConvert_config_ScoringStrategy_To_v1_ScoringStrategy(in *config.ScoringStrategy, out *ScoringStrategy, s conversion.Scope) error {
 	out.Type = ScoringStrategyType(in.Type)
 	out.Resources = *(*[]configv1.ResourceSpec)(unsafe.Pointer(&in.Resources))
+	out.DistanceWeighted = in.DistanceWeighted
 	return nil
 }
 
@@ -544,6 +742,17 @@ func autoConvert_v1_SySchedArgs_To_config_SySchedArgs(in *SySchedArgs, out *conf
 	if err := metav1.Convert_Pointer_string_To_string(&in.DefaultProfileName, &out.DefaultProfileName, s); err != nil {
 		return err
 	}
+	out.SyscallWeights = *(*map[string]int64)(unsafe.Pointer(&in.SyscallWeights))
+	if err := metav1.Convert_Pointer_string_To_string(&in.RiskCatalogueNamespace, &out.RiskCatalogueNamespace, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_Pointer_string_To_string(&in.RiskCatalogueName, &out.RiskCatalogueName, s); err != nil {
+		return err
+	}
+	out.MaxExtraneousSyscalls = (*int64)(unsafe.Pointer(in.MaxExtraneousSyscalls))
+	if err := metav1.Convert_Pointer_bool_To_bool(&in.RejectUnconfinedColocation, &out.RejectUnconfinedColocation, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -559,6 +768,17 @@ func autoConvert_config_SySchedArgs_To_v1_SySchedArgs(in *config.SySchedArgs, ou
 	if err := metav1.Convert_string_To_Pointer_string(&in.DefaultProfileName, &out.DefaultProfileName, s); err != nil {
 		return err
 	}
+	out.SyscallWeights = *(*map[string]int64)(unsafe.Pointer(&in.SyscallWeights))
+	if err := metav1.Convert_string_To_Pointer_string(&in.RiskCatalogueNamespace, &out.RiskCatalogueNamespace, s); err != nil {
+		return err
+	}
+	if err := metav1.Convert_string_To_Pointer_string(&in.RiskCatalogueName, &out.RiskCatalogueName, s); err != nil {
+		return err
+	}
+	out.MaxExtraneousSyscalls = (*int64)(unsafe.Pointer(in.MaxExtraneousSyscalls))
+	if err := metav1.Convert_bool_To_Pointer_bool(&in.RejectUnconfinedColocation, &out.RejectUnconfinedColocation, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -41,7 +41,10 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&NodeResourcesAllocatableArgs{}, func(obj interface{}) {
 		SetObjectDefaults_NodeResourcesAllocatableArgs(obj.(*NodeResourcesAllocatableArgs))
 	})
+	scheme.AddTypeDefaultingFunc(&NodeResourcesStrandedArgs{}, func(obj interface{}) { SetObjectDefaults_NodeResourcesStrandedArgs(obj.(*NodeResourcesStrandedArgs)) })
+	scheme.AddTypeDefaultingFunc(&PodStateArgs{}, func(obj interface{}) { SetObjectDefaults_PodStateArgs(obj.(*PodStateArgs)) })
 	scheme.AddTypeDefaultingFunc(&PreemptionTolerationArgs{}, func(obj interface{}) { SetObjectDefaults_PreemptionTolerationArgs(obj.(*PreemptionTolerationArgs)) })
+	scheme.AddTypeDefaultingFunc(&QOSSortArgs{}, func(obj interface{}) { SetObjectDefaults_QOSSortArgs(obj.(*QOSSortArgs)) })
 	scheme.AddTypeDefaultingFunc(&SySchedArgs{}, func(obj interface{}) { SetObjectDefaults_SySchedArgs(obj.(*SySchedArgs)) })
 	scheme.AddTypeDefaultingFunc(&TargetLoadPackingArgs{}, func(obj interface{}) { SetObjectDefaults_TargetLoadPackingArgs(obj.(*TargetLoadPackingArgs)) })
 	scheme.AddTypeDefaultingFunc(&TopologicalSortArgs{}, func(obj interface{}) { SetObjectDefaults_TopologicalSortArgs(obj.(*TopologicalSortArgs)) })
@@ -72,10 +75,22 @@ func SetObjectDefaults_NodeResourcesAllocatableArgs(in *NodeResourcesAllocatable
 	SetDefaults_NodeResourcesAllocatableArgs(in)
 }
 
+func SetObjectDefaults_NodeResourcesStrandedArgs(in *NodeResourcesStrandedArgs) {
+	SetDefaults_NodeResourcesStrandedArgs(in)
+}
+
+func SetObjectDefaults_PodStateArgs(in *PodStateArgs) {
+	SetDefaults_PodStateArgs(in)
+}
+
 func SetObjectDefaults_PreemptionTolerationArgs(in *PreemptionTolerationArgs) {
 	SetDefaults_PreemptionTolerationArgs(in)
 }
 
+func SetObjectDefaults_QOSSortArgs(in *QOSSortArgs) {
+	SetDefaults_QOSSortArgs(in)
+}
+
 func SetObjectDefaults_SySchedArgs(in *SySchedArgs) {
 	SetDefaults_SySchedArgs(in)
 }
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	schedconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
+	schedvalidation "k8s.io/kubernetes/pkg/scheduler/apis/config/validation"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+// ValidateProfilesPluginArgs validates the args of the out-of-tree plugins configured in the
+// profiles of a KubeSchedulerConfiguration, which the in-tree validation leaves out. The args of
+// other plugins are ignored.
+func ValidateProfilesPluginArgs(profiles []schedconfig.KubeSchedulerProfile) error {
+	var errs []error
+	for i := range profiles {
+		for j, pluginConfig := range profiles[i].PluginConfig {
+			path := field.NewPath("profiles").Index(i).Child("pluginConfig").Index(j).Child("args")
+			if err := validatePluginArgs(path, pluginConfig.Args); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func validatePluginArgs(path *field.Path, args runtime.Object) error {
+	switch args := args.(type) {
+	case *config.CoschedulingArgs:
+		return ValidateCoschedulingArgs(path, args)
+	case *config.NodeResourcesAllocatableArgs:
+		return ValidateNodeResourcesAllocatableArgs(path, args)
+	case *config.NodeResourcesStrandedArgs:
+		return ValidateNodeResourcesStrandedArgs(path, args)
+	case *config.NodeResourceTopologyMatchArgs:
+		return ValidateNodeResourceTopologyMatchArgs(path, args)
+	case *config.TargetLoadPackingArgs:
+		return ValidateTargetLoadPackingArgs(path, args)
+	case *config.LoadVariationRiskBalancingArgs:
+		return ValidateLoadVariationRiskBalancingArgs(path, args)
+	case *config.LowRiskOverCommitmentArgs:
+		return ValidateLowRiskOverCommitmentArgs(path, args)
+	case *config.TopologicalSortArgs:
+		return ValidateTopologicalSortArgs(path, args)
+	case *config.NetworkOverheadArgs:
+		return ValidateNetworkOverheadArgs(path, args)
+	case *config.SySchedArgs:
+		return ValidateSySchedArgs(path, args)
+	case *config.QOSSortArgs:
+		return ValidateQOSSortArgs(path, args)
+	case *config.PodStateArgs:
+		return ValidatePodStateArgs(path, args)
+	case *config.PeaksArgs:
+		return ValidatePeaksArgs(path, args)
+	case *config.PreemptionTolerationArgs:
+		return schedvalidation.ValidateDefaultPreemptionArgs(path, (*schedconfig.DefaultPreemptionArgs)(args))
+	}
+	return nil
+}
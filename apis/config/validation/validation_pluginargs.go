@@ -17,8 +17,13 @@ limitations under the License.
 package validation
 
 import (
+	"math"
+	"strconv"
+
 	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	schedconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
 
 	"sigs.k8s.io/scheduler-plugins/apis/config"
 )
@@ -36,6 +41,13 @@ func ValidateNodeResourceTopologyMatchArgs(path *field.Path, args *config.NodeRe
 	if err := validateScoringStrategyType(args.ScoringStrategy.Type, scoringStrategyTypePath); err != nil {
 		allErrs = append(allErrs, err)
 	}
+	allErrs = append(allErrs, validateResourceWeights(path.Child("scoringStrategy", "resources"), args.ScoringStrategy.Resources)...)
+	if args.CacheResyncPeriodSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("cacheResyncPeriodSeconds"), args.CacheResyncPeriodSeconds, "must not be negative"))
+	}
+	if args.Cache != nil {
+		allErrs = append(allErrs, validateNodeResourceTopologyCache(path.Child("cache"), args.Cache)...)
+	}
 
 	return allErrs.ToAggregate()
 }
@@ -46,3 +58,323 @@ func validateScoringStrategyType(scoringStrategy config.ScoringStrategyType, pat
 	}
 	return nil
 }
+
+var (
+	validForeignPodsDetectMode = sets.NewString(
+		string(config.ForeignPodsDetectNone),
+		string(config.ForeignPodsDetectAll),
+		string(config.ForeignPodsDetectOnlyExclusiveResources),
+	)
+	validCacheResyncMethod = sets.NewString(
+		string(config.CacheResyncAutodetect),
+		string(config.CacheResyncAll),
+		string(config.CacheResyncOnlyExclusiveResources),
+	)
+	validCacheInformerMode = sets.NewString(
+		string(config.CacheInformerShared),
+		string(config.CacheInformerDedicated),
+	)
+	validCacheResyncScope = sets.NewString(
+		string(config.CacheResyncScopeAll),
+		string(config.CacheResyncScopeOnlyResources),
+	)
+	validCacheResyncTrigger = sets.NewString(
+		string(config.CacheResyncTriggerPeriodic),
+		string(config.CacheResyncTriggerEvent),
+	)
+)
+
+// validateNodeResourceTopologyCache validates the cache options which are set;
+// the unset ones are defaulted by the plugin.
+func validateNodeResourceTopologyCache(path *field.Path, cache *config.NodeResourceTopologyCache) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, opt := range []struct {
+		name  string
+		value *string
+		valid sets.String
+	}{
+		{"foreignPodsDetect", (*string)(cache.ForeignPodsDetect), validForeignPodsDetectMode},
+		{"resyncMethod", (*string)(cache.ResyncMethod), validCacheResyncMethod},
+		{"informerMode", (*string)(cache.InformerMode), validCacheInformerMode},
+		{"resyncScope", (*string)(cache.ResyncScope), validCacheResyncScope},
+		{"resyncTrigger", (*string)(cache.ResyncTrigger), validCacheResyncTrigger},
+	} {
+		if opt.value != nil && !opt.valid.Has(*opt.value) {
+			allErrs = append(allErrs, field.NotSupported(path.Child(opt.name), *opt.value, opt.valid.List()))
+		}
+	}
+	return allErrs
+}
+
+var validPodStateScoringMode = sets.NewString(
+	string(config.PodStateScoringPodCount),
+	string(config.PodStateScoringResources),
+)
+
+func ValidatePodStateArgs(path *field.Path, args *config.PodStateArgs) error {
+	var allErrs field.ErrorList
+	for _, weight := range []struct {
+		name  string
+		value int64
+	}{
+		{"terminatingWeight", args.TerminatingWeight},
+		{"nominatedWeight", args.NominatedWeight},
+		{"recentlyBoundWeight", args.RecentlyBoundWeight},
+	} {
+		if weight.value < 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child(weight.name), weight.value, "weight must not be negative"))
+		}
+	}
+	if !validPodStateScoringMode.Has(string(args.ScoringMode)) {
+		allErrs = append(allErrs, field.Invalid(path.Child("scoringMode"), args.ScoringMode, "invalid PodStateScoringMode"))
+	}
+	if args.ScoringMode == config.PodStateScoringResources {
+		resourcesPath := path.Child("resources")
+		if len(args.Resources) == 0 {
+			allErrs = append(allErrs, field.Required(resourcesPath, "at least one resource is required by the Resources scoring mode"))
+		}
+		allErrs = append(allErrs, validateResourceWeights(resourcesPath, args.Resources)...)
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func ValidateNodeResourcesStrandedArgs(path *field.Path, args *config.NodeResourcesStrandedArgs) error {
+	var allErrs field.ErrorList
+	resourcesPath := path.Child("resources")
+	if len(args.Resources) == 0 {
+		allErrs = append(allErrs, field.Required(resourcesPath, "at least one resource is required"))
+	}
+	scored := sets.NewString()
+	for i, resource := range args.Resources {
+		if resource.Weight <= 0 {
+			allErrs = append(allErrs, field.Invalid(resourcesPath.Index(i).Child("weight"), resource.Weight, "resource weight must be positive"))
+		}
+		scored.Insert(resource.Name)
+	}
+
+	podShapesPath := path.Child("podShapes")
+	if len(args.PodShapes) == 0 {
+		allErrs = append(allErrs, field.Required(podShapesPath, "at least one pod shape is required"))
+	}
+	for i, shape := range args.PodShapes {
+		shapePath := podShapesPath.Index(i)
+		if shape.Weight <= 0 {
+			allErrs = append(allErrs, field.Invalid(shapePath.Child("weight"), shape.Weight, "pod shape weight must be positive"))
+		}
+		requested := false
+		for name, quantity := range shape.Requests {
+			if quantity.Sign() < 0 {
+				allErrs = append(allErrs, field.Invalid(shapePath.Child("requests").Key(string(name)), quantity.String(), "request must not be negative"))
+			}
+			if scored.Has(string(name)) && quantity.Sign() > 0 {
+				requested = true
+			}
+		}
+		if !requested {
+			allErrs = append(allErrs, field.Invalid(shapePath.Child("requests"), shape.Requests, "pod shape must request at least one of the scored resources"))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func ValidateCoschedulingArgs(path *field.Path, args *config.CoschedulingArgs) error {
+	var allErrs field.ErrorList
+	if args.PermitWaitingTimeSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("permitWaitingTimeSeconds"), args.PermitWaitingTimeSeconds, "must not be negative"))
+	}
+	if args.PodGroupBackoffSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("podGroupBackoffSeconds"), args.PodGroupBackoffSeconds, "must not be negative"))
+	}
+
+	return allErrs.ToAggregate()
+}
+
+var validNodeResourcesAllocatableMode = sets.NewString(
+	string(config.Least),
+	string(config.Most),
+	string(config.RatioMatching),
+)
+
+func ValidateNodeResourcesAllocatableArgs(path *field.Path, args *config.NodeResourcesAllocatableArgs) error {
+	var allErrs field.ErrorList
+	// An empty mode is defaulted by the plugin.
+	if args.Mode != "" && !validNodeResourcesAllocatableMode.Has(string(args.Mode)) {
+		allErrs = append(allErrs, field.Invalid(path.Child("mode"), args.Mode, "invalid ModeType"))
+	}
+	allErrs = append(allErrs, validateResourceWeights(path.Child("resources"), args.Resources)...)
+	if args.ExtendedResourceWeight < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("extendedResourceWeight"), args.ExtendedResourceWeight, "weight must not be negative"))
+	}
+
+	return allErrs.ToAggregate()
+}
+
+var validMetricProviderType = sets.NewString(
+	string(config.KubernetesMetricsServer),
+	string(config.Prometheus),
+	string(config.SignalFx),
+)
+
+// validateTrimaranSpec validates the parameters shared by the Trimaran plugins.
+// The metric provider is only used when no load watcher address is set.
+func validateTrimaranSpec(path *field.Path, spec *config.TrimaranSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.WatcherAddress == "" && !validMetricProviderType.Has(string(spec.MetricProvider.Type)) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("metricProvider", "type"), spec.MetricProvider.Type, validMetricProviderType.List()))
+	}
+	return allErrs
+}
+
+func ValidateTargetLoadPackingArgs(path *field.Path, args *config.TargetLoadPackingArgs) error {
+	allErrs := validateTrimaranSpec(path, &args.TrimaranSpec)
+	if args.TargetUtilization < 0 || args.TargetUtilization > 100 {
+		allErrs = append(allErrs, field.Invalid(path.Child("targetUtilization"), args.TargetUtilization, "must be a percentage between 0 and 100"))
+	}
+	for name, quantity := range args.DefaultRequests {
+		if quantity.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("defaultRequests").Key(string(name)), quantity.String(), "request must not be negative"))
+		}
+	}
+	multiplierPath := path.Child("defaultRequestsMultiplier")
+	if multiplier, err := strconv.ParseFloat(args.DefaultRequestsMultiplier, 64); err != nil {
+		allErrs = append(allErrs, field.Invalid(multiplierPath, args.DefaultRequestsMultiplier, "must be a floating point number"))
+	} else if multiplier < 0 {
+		allErrs = append(allErrs, field.Invalid(multiplierPath, args.DefaultRequestsMultiplier, "must not be negative"))
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func ValidateLoadVariationRiskBalancingArgs(path *field.Path, args *config.LoadVariationRiskBalancingArgs) error {
+	allErrs := validateTrimaranSpec(path, &args.TrimaranSpec)
+	if args.SafeVarianceMargin < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("safeVarianceMargin"), args.SafeVarianceMargin, "must not be negative"))
+	}
+	if args.SafeVarianceSensitivity < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("safeVarianceSensitivity"), args.SafeVarianceSensitivity, "must not be negative"))
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func ValidateLowRiskOverCommitmentArgs(path *field.Path, args *config.LowRiskOverCommitmentArgs) error {
+	allErrs := validateTrimaranSpec(path, &args.TrimaranSpec)
+	if args.SmoothingWindowSize <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("smoothingWindowSize"), args.SmoothingWindowSize, "must be positive"))
+	}
+	for name, weight := range args.RiskLimitWeights {
+		if weight < 0 || weight > 1 {
+			allErrs = append(allErrs, field.Invalid(path.Child("riskLimitWeights").Key(string(name)), weight, "must be between 0 and 1"))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func ValidateTopologicalSortArgs(path *field.Path, args *config.TopologicalSortArgs) error {
+	allErrs := validateNamespaces(path.Child("namespaces"), args.Namespaces)
+
+	return allErrs.ToAggregate()
+}
+
+func ValidateNetworkOverheadArgs(path *field.Path, args *config.NetworkOverheadArgs) error {
+	allErrs := validateNamespaces(path.Child("namespaces"), args.Namespaces)
+	if args.WeightsName == "" {
+		allErrs = append(allErrs, field.Required(path.Child("weightsName"), "name of the weights is required"))
+	}
+	if args.NetworkTopologyName == "" {
+		allErrs = append(allErrs, field.Required(path.Child("networkTopologyName"), "name of the NetworkTopology is required"))
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func ValidateSySchedArgs(path *field.Path, args *config.SySchedArgs) error {
+	var allErrs field.ErrorList
+	if args.DefaultProfileNamespace == "" {
+		allErrs = append(allErrs, field.Required(path.Child("defaultProfileNamespace"), "namespace of the default profile is required"))
+	}
+	if args.DefaultProfileName == "" {
+		allErrs = append(allErrs, field.Required(path.Child("defaultProfileName"), "name of the default profile is required"))
+	}
+	for syscall, weight := range args.SyscallWeights {
+		if weight < 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("syscallWeights").Key(syscall), weight, "weight must not be negative"))
+		}
+	}
+	if args.MaxExtraneousSyscalls != nil && *args.MaxExtraneousSyscalls < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("maxExtraneousSyscalls"), *args.MaxExtraneousSyscalls, "must not be negative"))
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func ValidatePeaksArgs(path *field.Path, args *config.PeaksArgs) error {
+	var allErrs field.ErrorList
+	for node, model := range args.NodePowerModel {
+		modelPath := path.Child("nodePowerModel").Key(node)
+		if node == "" {
+			allErrs = append(allErrs, field.Invalid(modelPath, node, "node name must not be empty"))
+		}
+		for _, k := range []struct {
+			name  string
+			value float64
+		}{
+			{"k0", model.K0},
+			{"k1", model.K1},
+			{"k2", model.K2},
+		} {
+			if math.IsNaN(k.value) || math.IsInf(k.value, 0) {
+				allErrs = append(allErrs, field.Invalid(modelPath.Child(k.name), k.value, "must be a finite number"))
+			}
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+var validQueueSortKeyType = sets.NewString(
+	string(config.QueueSortKeyPriority),
+	string(config.QueueSortKeyQOSClass),
+	string(config.QueueSortKeyRequestedSize),
+	string(config.QueueSortKeyNamespaceWeight),
+	string(config.QueueSortKeyPodLabel),
+	string(config.QueueSortKeyCreationTime),
+)
+
+func ValidateQOSSortArgs(path *field.Path, args *config.QOSSortArgs) error {
+	var allErrs field.ErrorList
+	for i, key := range args.Keys {
+		keyPath := path.Child("keys").Index(i)
+		if !validQueueSortKeyType.Has(string(key.Type)) {
+			allErrs = append(allErrs, field.NotSupported(keyPath.Child("type"), key.Type, validQueueSortKeyType.List()))
+		}
+		if key.Type == config.QueueSortKeyPodLabel && key.LabelKey == "" {
+			allErrs = append(allErrs, field.Required(keyPath.Child("labelKey"), "labelKey is required by the PodLabel queue sort key"))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validateResourceWeights(path *field.Path, resources []schedconfig.ResourceSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, resource := range resources {
+		if resource.Weight <= 0 {
+			allErrs = append(allErrs, field.Invalid(path.Index(i).Child("weight"), resource.Weight, "resource weight must be positive"))
+		}
+	}
+	return allErrs
+}
+
+func validateNamespaces(path *field.Path, namespaces []string) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, ns := range namespaces {
+		for _, msg := range utilvalidation.IsDNS1123Label(ns) {
+			allErrs = append(allErrs, field.Invalid(path.Index(i), ns, msg))
+		}
+	}
+	return allErrs
+}
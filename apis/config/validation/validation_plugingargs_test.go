@@ -18,9 +18,14 @@ package validation
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	schedconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
+
 	"sigs.k8s.io/scheduler-plugins/apis/config"
 )
 
@@ -47,6 +52,28 @@ func TestValidateNodeResourceTopologyMatchArgs(t *testing.T) {
 			},
 			expectedErr: fmt.Errorf("scoringStrategy.type: Invalid value:"),
 		},
+		{
+			description: "incorrect config, negative CacheResyncPeriodSeconds",
+			args: &config.NodeResourceTopologyMatchArgs{
+				ScoringStrategy: config.ScoringStrategy{
+					Type: config.LeastAllocated,
+				},
+				CacheResyncPeriodSeconds: -1,
+			},
+			expectedErr: fmt.Errorf("cacheResyncPeriodSeconds: Invalid value: -1"),
+		},
+		{
+			description: "incorrect config, wrong cache informer mode",
+			args: &config.NodeResourceTopologyMatchArgs{
+				ScoringStrategy: config.ScoringStrategy{
+					Type: config.LeastAllocated,
+				},
+				Cache: &config.NodeResourceTopologyCache{
+					InformerMode: func() *config.CacheInformerMode { mode := config.CacheInformerMode("not existent"); return &mode }(),
+				},
+			},
+			expectedErr: fmt.Errorf("cache.informerMode: Unsupported value:"),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -67,3 +94,402 @@ func TestValidateNodeResourceTopologyMatchArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePodStateArgs(t *testing.T) {
+	testCases := []struct {
+		args        *config.PodStateArgs
+		expectedErr error
+		description string
+	}{
+		{
+			description: "correct config",
+			args: &config.PodStateArgs{
+				TerminatingWeight: 1,
+				NominatedWeight:   1,
+				ScoringMode:       config.PodStateScoringPodCount,
+			},
+		},
+		{
+			description: "correct config, Resources scoring mode",
+			args: &config.PodStateArgs{
+				TerminatingWeight: 2,
+				ScoringMode:       config.PodStateScoringResources,
+				Resources:         []schedconfig.ResourceSpec{{Name: "cpu", Weight: 1}},
+			},
+		},
+		{
+			description: "incorrect config, negative weight",
+			args: &config.PodStateArgs{
+				NominatedWeight: -1,
+				ScoringMode:     config.PodStateScoringPodCount,
+			},
+			expectedErr: fmt.Errorf("nominatedWeight: Invalid value:"),
+		},
+		{
+			description: "incorrect config, wrong ScoringMode",
+			args: &config.PodStateArgs{
+				ScoringMode: "not existent",
+			},
+			expectedErr: fmt.Errorf("scoringMode: Invalid value:"),
+		},
+		{
+			description: "incorrect config, Resources scoring mode without resources",
+			args: &config.PodStateArgs{
+				ScoringMode: config.PodStateScoringResources,
+			},
+			expectedErr: fmt.Errorf("resources: Required value"),
+		},
+		{
+			description: "incorrect config, zero resource weight",
+			args: &config.PodStateArgs{
+				ScoringMode: config.PodStateScoringResources,
+				Resources:   []schedconfig.ResourceSpec{{Name: "cpu", Weight: 0}},
+			},
+			expectedErr: fmt.Errorf("resources[0].weight: Invalid value:"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			err := ValidatePodStateArgs(nil, testCase.args)
+			if testCase.expectedErr != nil {
+				if err == nil {
+					t.Fatalf("expected err to equal %v not nil", testCase.expectedErr)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedErr.Error()) {
+					t.Errorf("expected err to contain %s in error message: %s", testCase.expectedErr.Error(), err.Error())
+				}
+			}
+			if testCase.expectedErr == nil && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateNodeResourcesStrandedArgs(t *testing.T) {
+	resources := []schedconfig.ResourceSpec{{Name: "cpu", Weight: 1}, {Name: "memory", Weight: 1}}
+	shape := config.PodShape{
+		Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("4Gi")},
+		Weight:   1,
+	}
+	testCases := []struct {
+		args        *config.NodeResourcesStrandedArgs
+		expectedErr error
+		description string
+	}{
+		{
+			description: "correct config",
+			args:        &config.NodeResourcesStrandedArgs{Resources: resources, PodShapes: []config.PodShape{shape}},
+		},
+		{
+			description: "incorrect config, no resources",
+			args:        &config.NodeResourcesStrandedArgs{PodShapes: []config.PodShape{shape}},
+			expectedErr: fmt.Errorf("resources: Required value"),
+		},
+		{
+			description: "incorrect config, zero resource weight",
+			args: &config.NodeResourcesStrandedArgs{
+				Resources: []schedconfig.ResourceSpec{{Name: "cpu", Weight: 0}},
+				PodShapes: []config.PodShape{shape},
+			},
+			expectedErr: fmt.Errorf("resources[0].weight: Invalid value:"),
+		},
+		{
+			description: "incorrect config, no pod shapes",
+			args:        &config.NodeResourcesStrandedArgs{Resources: resources},
+			expectedErr: fmt.Errorf("podShapes: Required value"),
+		},
+		{
+			description: "incorrect config, zero pod shape weight",
+			args: &config.NodeResourcesStrandedArgs{
+				Resources: resources,
+				PodShapes: []config.PodShape{{Requests: shape.Requests}},
+			},
+			expectedErr: fmt.Errorf("podShapes[0].weight: Invalid value:"),
+		},
+		{
+			description: "incorrect config, negative pod shape request",
+			args: &config.NodeResourcesStrandedArgs{
+				Resources: resources,
+				PodShapes: []config.PodShape{{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("-1Gi")}, Weight: 1}},
+			},
+			expectedErr: fmt.Errorf("podShapes[0].requests[memory]: Invalid value:"),
+		},
+		{
+			description: "incorrect config, pod shape without scored resource",
+			args: &config.NodeResourcesStrandedArgs{
+				Resources: resources,
+				PodShapes: []config.PodShape{{Requests: corev1.ResourceList{"example.com/gpu": resource.MustParse("1")}, Weight: 1}},
+			},
+			expectedErr: fmt.Errorf("podShapes[0].requests: Invalid value:"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			err := ValidateNodeResourcesStrandedArgs(nil, testCase.args)
+			if testCase.expectedErr != nil {
+				if err == nil {
+					t.Fatalf("expected err to equal %v not nil", testCase.expectedErr)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedErr.Error()) {
+					t.Errorf("expected err to contain %s in error message: %s", testCase.expectedErr.Error(), err.Error())
+				}
+			}
+			if testCase.expectedErr == nil && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidatePluginArgs(t *testing.T) {
+	metricsServer := config.TrimaranSpec{MetricProvider: config.MetricProviderSpec{Type: config.KubernetesMetricsServer}}
+	testCases := []struct {
+		validate    func() error
+		expectedErr error
+		description string
+	}{
+		{
+			description: "correct Coscheduling config",
+			validate: func() error {
+				return ValidateCoschedulingArgs(nil, &config.CoschedulingArgs{PermitWaitingTimeSeconds: 60, PodGroupBackoffSeconds: 0})
+			},
+		},
+		{
+			description: "incorrect Coscheduling config, negative backoff",
+			validate: func() error {
+				return ValidateCoschedulingArgs(nil, &config.CoschedulingArgs{PermitWaitingTimeSeconds: 60, PodGroupBackoffSeconds: -1})
+			},
+			expectedErr: fmt.Errorf("podGroupBackoffSeconds: Invalid value: -1"),
+		},
+		{
+			description: "correct NodeResourcesAllocatable config",
+			validate: func() error {
+				return ValidateNodeResourcesAllocatableArgs(nil, &config.NodeResourcesAllocatableArgs{
+					Mode:      config.RatioMatching,
+					Resources: []schedconfig.ResourceSpec{{Name: "cpu", Weight: 1 << 20}, {Name: "memory", Weight: 1}},
+				})
+			},
+		},
+		{
+			description: "incorrect NodeResourcesAllocatable config, wrong mode",
+			validate: func() error {
+				return ValidateNodeResourcesAllocatableArgs(nil, &config.NodeResourcesAllocatableArgs{Mode: "not existent"})
+			},
+			expectedErr: fmt.Errorf("mode: Invalid value:"),
+		},
+		{
+			description: "incorrect NodeResourcesAllocatable config, zero resource weight",
+			validate: func() error {
+				return ValidateNodeResourcesAllocatableArgs(nil, &config.NodeResourcesAllocatableArgs{
+					Resources: []schedconfig.ResourceSpec{{Name: "cpu", Weight: 0}},
+				})
+			},
+			expectedErr: fmt.Errorf("resources[0].weight: Invalid value: 0"),
+		},
+		{
+			description: "correct TargetLoadPacking config",
+			validate: func() error {
+				return ValidateTargetLoadPackingArgs(nil, &config.TargetLoadPackingArgs{
+					TrimaranSpec:              metricsServer,
+					DefaultRequests:           corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+					DefaultRequestsMultiplier: "1.5",
+					TargetUtilization:         40,
+				})
+			},
+		},
+		{
+			description: "incorrect TargetLoadPacking config, wrong metric provider",
+			validate: func() error {
+				return ValidateTargetLoadPackingArgs(nil, &config.TargetLoadPackingArgs{
+					TrimaranSpec:              config.TrimaranSpec{MetricProvider: config.MetricProviderSpec{Type: "not existent"}},
+					DefaultRequestsMultiplier: "1.5",
+					TargetUtilization:         40,
+				})
+			},
+			expectedErr: fmt.Errorf("metricProvider.type: Unsupported value:"),
+		},
+		{
+			description: "correct TargetLoadPacking config, metric provider ignored with a load watcher",
+			validate: func() error {
+				return ValidateTargetLoadPackingArgs(nil, &config.TargetLoadPackingArgs{
+					TrimaranSpec:              config.TrimaranSpec{WatcherAddress: "http://load-watcher:2020"},
+					DefaultRequestsMultiplier: "1.5",
+					TargetUtilization:         40,
+				})
+			},
+		},
+		{
+			description: "incorrect TargetLoadPacking config, utilization out of range",
+			validate: func() error {
+				return ValidateTargetLoadPackingArgs(nil, &config.TargetLoadPackingArgs{
+					TrimaranSpec:              metricsServer,
+					DefaultRequestsMultiplier: "1.5",
+					TargetUtilization:         120,
+				})
+			},
+			expectedErr: fmt.Errorf("targetUtilization: Invalid value: 120"),
+		},
+		{
+			description: "incorrect TargetLoadPacking config, multiplier not a number",
+			validate: func() error {
+				return ValidateTargetLoadPackingArgs(nil, &config.TargetLoadPackingArgs{
+					TrimaranSpec:              metricsServer,
+					DefaultRequestsMultiplier: "x",
+					TargetUtilization:         40,
+				})
+			},
+			expectedErr: fmt.Errorf("defaultRequestsMultiplier: Invalid value: \"x\""),
+		},
+		{
+			description: "incorrect LoadVariationRiskBalancing config, negative margin",
+			validate: func() error {
+				return ValidateLoadVariationRiskBalancingArgs(nil, &config.LoadVariationRiskBalancingArgs{
+					TrimaranSpec:            metricsServer,
+					SafeVarianceMargin:      -1,
+					SafeVarianceSensitivity: 1,
+				})
+			},
+			expectedErr: fmt.Errorf("safeVarianceMargin: Invalid value: -1"),
+		},
+		{
+			description: "correct LowRiskOverCommitment config",
+			validate: func() error {
+				return ValidateLowRiskOverCommitmentArgs(nil, &config.LowRiskOverCommitmentArgs{
+					TrimaranSpec:        metricsServer,
+					SmoothingWindowSize: 5,
+					RiskLimitWeights:    map[corev1.ResourceName]float64{corev1.ResourceCPU: 0.5},
+				})
+			},
+		},
+		{
+			description: "incorrect LowRiskOverCommitment config, risk limit weight out of range",
+			validate: func() error {
+				return ValidateLowRiskOverCommitmentArgs(nil, &config.LowRiskOverCommitmentArgs{
+					TrimaranSpec:        metricsServer,
+					SmoothingWindowSize: 5,
+					RiskLimitWeights:    map[corev1.ResourceName]float64{corev1.ResourceMemory: 1.5},
+				})
+			},
+			expectedErr: fmt.Errorf("riskLimitWeights[memory]: Invalid value: 1.5"),
+		},
+		{
+			description: "incorrect TopologicalSort config, invalid namespace",
+			validate: func() error {
+				return ValidateTopologicalSortArgs(nil, &config.TopologicalSortArgs{Namespaces: []string{"default", "Not_A_Namespace"}})
+			},
+			expectedErr: fmt.Errorf("namespaces[1]: Invalid value: \"Not_A_Namespace\""),
+		},
+		{
+			description: "correct NetworkOverhead config",
+			validate: func() error {
+				return ValidateNetworkOverheadArgs(nil, &config.NetworkOverheadArgs{
+					Namespaces:          []string{"default"},
+					WeightsName:         "UserDefined",
+					NetworkTopologyName: "nt-default",
+				})
+			},
+		},
+		{
+			description: "incorrect NetworkOverhead config, missing NetworkTopology name",
+			validate: func() error {
+				return ValidateNetworkOverheadArgs(nil, &config.NetworkOverheadArgs{WeightsName: "UserDefined"})
+			},
+			expectedErr: fmt.Errorf("networkTopologyName: Required value"),
+		},
+		{
+			description: "incorrect SySched config, negative syscall weight",
+			validate: func() error {
+				return ValidateSySchedArgs(nil, &config.SySchedArgs{
+					DefaultProfileNamespace: "default",
+					DefaultProfileName:      "all-syscalls",
+					SyscallWeights:          map[string]int64{"ptrace": -1},
+				})
+			},
+			expectedErr: fmt.Errorf("syscallWeights[ptrace]: Invalid value: -1"),
+		},
+		{
+			description: "incorrect SySched config, negative maxExtraneousSyscalls",
+			validate: func() error {
+				limit := int64(-1)
+				return ValidateSySchedArgs(nil, &config.SySchedArgs{
+					DefaultProfileNamespace: "default",
+					DefaultProfileName:      "all-syscalls",
+					MaxExtraneousSyscalls:   &limit,
+				})
+			},
+			expectedErr: fmt.Errorf("maxExtraneousSyscalls: Invalid value: -1"),
+		},
+		{
+			description: "incorrect Peaks config, infinite power model coefficient",
+			validate: func() error {
+				return ValidatePeaksArgs(nil, &config.PeaksArgs{
+					NodePowerModel: map[string]config.PowerModel{"node-1": {K0: 1, K1: math.Inf(1)}},
+				})
+			},
+			expectedErr: fmt.Errorf("nodePowerModel[node-1].k1: Invalid value:"),
+		},
+		{
+			description: "correct QOSSort config",
+			validate: func() error {
+				return ValidateQOSSortArgs(nil, &config.QOSSortArgs{Keys: []config.QueueSortKey{
+					{Type: config.QueueSortKeyPriority},
+					{Type: config.QueueSortKeyPodLabel, LabelKey: "tier"},
+				}})
+			},
+		},
+		{
+			description: "incorrect QOSSort config, wrong key type",
+			validate: func() error {
+				return ValidateQOSSortArgs(nil, &config.QOSSortArgs{Keys: []config.QueueSortKey{{Type: "not existent"}}})
+			},
+			expectedErr: fmt.Errorf("keys[0].type: Unsupported value:"),
+		},
+		{
+			description: "incorrect args in the profiles, with their path",
+			validate: func() error {
+				return ValidateProfilesPluginArgs([]schedconfig.KubeSchedulerProfile{
+					{PluginConfig: []schedconfig.PluginConfig{{Args: &config.CoschedulingArgs{}}}},
+					{PluginConfig: []schedconfig.PluginConfig{
+						{Args: &schedconfig.NodeResourcesFitArgs{}},
+						{Args: &config.CoschedulingArgs{PodGroupBackoffSeconds: -1}},
+					}},
+				})
+			},
+			expectedErr: fmt.Errorf("profiles[1].pluginConfig[1].args.podGroupBackoffSeconds: Invalid value: -1"),
+		},
+		{
+			description: "correct args in the profiles, in-tree args ignored",
+			validate: func() error {
+				return ValidateProfilesPluginArgs([]schedconfig.KubeSchedulerProfile{
+					{PluginConfig: []schedconfig.PluginConfig{
+						{Args: &schedconfig.NodeResourcesFitArgs{}},
+						{Args: &config.CoschedulingArgs{PermitWaitingTimeSeconds: 60}},
+					}},
+				})
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			err := testCase.validate()
+			if testCase.expectedErr != nil {
+				if err == nil {
+					t.Fatalf("expected err to equal %v not nil", testCase.expectedErr)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedErr.Error()) {
+					t.Errorf("expected err to contain %s in error message: %s", testCase.expectedErr.Error(), err.Error())
+				}
+			}
+			if testCase.expectedErr == nil && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -180,6 +180,11 @@ func (in *NodeResourceTopologyCache) DeepCopyInto(out *NodeResourceTopologyCache
 		*out = new(CacheResyncScope)
 		**out = **in
 	}
+	if in.ResyncTrigger != nil {
+		in, out := &in.ResyncTrigger, &out.ResyncTrigger
+		*out = new(CacheResyncTrigger)
+		**out = **in
+	}
 	return
 }
 
@@ -254,6 +259,43 @@ func (in *NodeResourcesAllocatableArgs) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeResourcesStrandedArgs) DeepCopyInto(out *NodeResourcesStrandedArgs) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]apisconfig.ResourceSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodShapes != nil {
+		in, out := &in.PodShapes, &out.PodShapes
+		*out = make([]PodShape, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeResourcesStrandedArgs.
+func (in *NodeResourcesStrandedArgs) DeepCopy() *NodeResourcesStrandedArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeResourcesStrandedArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeResourcesStrandedArgs) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PeaksArgs) DeepCopyInto(out *PeaksArgs) {
 	*out = *in
@@ -286,6 +328,59 @@ func (in *PeaksArgs) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodShape) DeepCopyInto(out *PodShape) {
+	*out = *in
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodShape.
+func (in *PodShape) DeepCopy() *PodShape {
+	if in == nil {
+		return nil
+	}
+	out := new(PodShape)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodStateArgs) DeepCopyInto(out *PodStateArgs) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]apisconfig.ResourceSpec, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodStateArgs.
+func (in *PodStateArgs) DeepCopy() *PodStateArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(PodStateArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodStateArgs) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PowerModel) DeepCopyInto(out *PowerModel) {
 	*out = *in
@@ -327,6 +422,66 @@ func (in *PreemptionTolerationArgs) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QOSSortArgs) DeepCopyInto(out *QOSSortArgs) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]QueueSortKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QOSSortArgs.
+func (in *QOSSortArgs) DeepCopy() *QOSSortArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(QOSSortArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QOSSortArgs) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueSortKey) DeepCopyInto(out *QueueSortKey) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]v1.ResourceName, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceWeights != nil {
+		in, out := &in.NamespaceWeights, &out.NamespaceWeights
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueSortKey.
+func (in *QueueSortKey) DeepCopy() *QueueSortKey {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueSortKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScoringStrategy) DeepCopyInto(out *ScoringStrategy) {
 	*out = *in
@@ -352,6 +507,18 @@ func (in *ScoringStrategy) DeepCopy() *ScoringStrategy {
 func (in *SySchedArgs) DeepCopyInto(out *SySchedArgs) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	if in.SyscallWeights != nil {
+		in, out := &in.SyscallWeights, &out.SyscallWeights
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MaxExtraneousSyscalls != nil {
+		in, out := &in.MaxExtraneousSyscalls, &out.MaxExtraneousSyscalls
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -21,6 +21,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
 	schedv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	schedv1beta1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1beta1"
 )
 
 func init() {
@@ -30,4 +31,5 @@ func init() {
 // AddToScheme builds the kubescheduler scheme using all known versions of the kubescheduler api.
 func AddToScheme(scheme *runtime.Scheme) {
 	utilruntime.Must(schedv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(schedv1beta1.AddToScheme(scheme))
 }
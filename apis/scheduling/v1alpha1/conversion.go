@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1beta1"
+)
+
+// ConvertTo converts this ElasticQuota to the hub version (v1beta1).
+func (src *ElasticQuota) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta1.ElasticQuota)
+	return Convert_v1alpha1_ElasticQuota_To_v1beta1_ElasticQuota(src, dst, nil)
+}
+
+// ConvertFrom converts from the hub version (v1beta1) to this version.
+func (dst *ElasticQuota) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.ElasticQuota)
+	return Convert_v1beta1_ElasticQuota_To_v1alpha1_ElasticQuota(src, dst, nil)
+}
+
+// ConvertTo converts this PodGroup to the hub version (v1beta1).
+func (src *PodGroup) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta1.PodGroup)
+	return Convert_v1alpha1_PodGroup_To_v1beta1_PodGroup(src, dst, nil)
+}
+
+// ConvertFrom converts from the hub version (v1beta1) to this version.
+func (dst *PodGroup) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.PodGroup)
+	return Convert_v1beta1_PodGroup_To_v1alpha1_PodGroup(src, dst, nil)
+}
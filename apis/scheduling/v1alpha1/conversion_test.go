@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"math/rand"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1beta1"
+)
+
+const fuzzIterations = 1000
+
+func quantityFuzzerFuncs(_ serializer.CodecFactory) []interface{} {
+	return []interface{}{
+		func(q *resource.Quantity, c fuzz.Continue) {
+			*q = *resource.NewQuantity(c.Int63n(1000), resource.DecimalSI)
+		},
+	}
+}
+
+func newFuzzer(t *testing.T) *fuzz.Fuzzer {
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	funcs := fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, quantityFuzzerFuncs)
+	return fuzzer.FuzzerFor(funcs, rand.NewSource(rand.Int63()), serializer.NewCodecFactory(scheme))
+}
+
+func TestFuzzyConversion(t *testing.T) {
+	tests := []struct {
+		name  string
+		hub   func() conversion.Hub
+		spoke func() conversion.Convertible
+	}{
+		{
+			name:  "ElasticQuota",
+			hub:   func() conversion.Hub { return &v1beta1.ElasticQuota{} },
+			spoke: func() conversion.Convertible { return &ElasticQuota{} },
+		},
+		{
+			name:  "PodGroup",
+			hub:   func() conversion.Hub { return &v1beta1.PodGroup{} },
+			spoke: func() conversion.Convertible { return &PodGroup{} },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+" spoke-hub-spoke", func(t *testing.T) {
+			f := newFuzzer(t)
+			for i := 0; i < fuzzIterations; i++ {
+				spokeBefore := tt.spoke()
+				f.Fuzz(spokeBefore)
+				hub := tt.hub()
+				if err := spokeBefore.ConvertTo(hub); err != nil {
+					t.Fatalf("failed to convert to hub: %v", err)
+				}
+				spokeAfter := tt.spoke()
+				if err := spokeAfter.ConvertFrom(hub); err != nil {
+					t.Fatalf("failed to convert from hub: %v", err)
+				}
+				if !apiequality.Semantic.DeepEqual(spokeBefore, spokeAfter) {
+					t.Fatalf("round trip changed the object:\nbefore: %+v\nafter:  %+v", spokeBefore, spokeAfter)
+				}
+			}
+		})
+		t.Run(tt.name+" hub-spoke-hub", func(t *testing.T) {
+			f := newFuzzer(t)
+			for i := 0; i < fuzzIterations; i++ {
+				hubBefore := tt.hub()
+				f.Fuzz(hubBefore)
+				spoke := tt.spoke()
+				if err := spoke.ConvertFrom(hubBefore); err != nil {
+					t.Fatalf("failed to convert from hub: %v", err)
+				}
+				hubAfter := tt.hub()
+				if err := spoke.ConvertTo(hubAfter); err != nil {
+					t.Fatalf("failed to convert to hub: %v", err)
+				}
+				if !apiequality.Semantic.DeepEqual(hubBefore, hubAfter) {
+					t.Fatalf("round trip changed the object:\nbefore: %+v\nafter:  %+v", hubBefore, hubAfter)
+				}
+			}
+		})
+	}
+}
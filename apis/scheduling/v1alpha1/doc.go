@@ -15,6 +15,7 @@ limitations under the License.
 */
 
 // +kubebuilder:object:generate=true
+// +k8s:conversion-gen=sigs.k8s.io/scheduler-plugins/apis/scheduling/v1beta1
 // +groupName=scheduling.x-k8s.io
 
 package v1alpha1
@@ -27,6 +27,7 @@ import (
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:shortName={eq,eqs}
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:metadata:annotations="api-approved.kubernetes.io=https://github.com/kubernetes-sigs/scheduler-plugins/pull/52"
 // +kubebuilder:printcolumn:name="Used",JSONPath=".status.used",type=string,description="Used is the current observed total usage of the resource in the namespace."
@@ -65,8 +66,39 @@ type ElasticQuotaStatus struct {
 	// Used is the current observed total usage of the resource in the namespace.
 	// +optional
 	Used v1.ResourceList `json:"used,omitempty" protobuf:"bytes,1,rep,name=used,casttype=ResourceList,castkey=ResourceName"`
+
+	// Borrowed is the usage of each resource beyond Min, borrowed from the unused Min of other quotas.
+	// A resource missing from Min is entirely borrowed.
+	// +optional
+	Borrowed v1.ResourceList `json:"borrowed,omitempty" protobuf:"bytes,2,rep,name=borrowed,casttype=ResourceList,castkey=ResourceName"`
+
+	// Lent is the part of the unused Min of each resource that other quotas borrow. What all the quotas
+	// borrow is lent by the quotas not using their Min, in proportion to their unused Min.
+	// +optional
+	Lent v1.ResourceList `json:"lent,omitempty" protobuf:"bytes,3,rep,name=lent,casttype=ResourceList,castkey=ResourceName"`
+
+	// PendingRequests is the total of the resource requests of the pods in the namespace waiting to be scheduled.
+	// +optional
+	PendingRequests v1.ResourceList `json:"pendingRequests,omitempty" protobuf:"bytes,4,rep,name=pendingRequests,casttype=ResourceList,castkey=ResourceName"`
+
+	// Conditions are the latest observations of the quota state, e.g. OverMin or AtMax.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" protobuf:"bytes,5,rep,name=conditions"`
 }
 
+// These are the condition types of an ElasticQuota.
+const (
+	// ElasticQuotaOverMin means that the namespace uses more than Min of at least one resource,
+	// borrowing from other quotas.
+	ElasticQuotaOverMin = "OverMin"
+
+	// ElasticQuotaAtMax means that the namespace uses all of Max of at least one resource, so that
+	// pods requesting it can not be scheduled until some are deleted.
+	ElasticQuotaAtMax = "AtMax"
+)
+
 // +kubebuilder:object:root=true
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -110,6 +142,10 @@ const (
 
 	// PodGroupLabel is the default label of coscheduling
 	PodGroupLabel = scheduling.GroupName + "/pod-group"
+
+	// AutoPodGroupKey is the namespace label or workload annotation that opts workloads in to
+	// automatic PodGroup creation. A workload annotated with "false" is opted out.
+	AutoPodGroupKey = scheduling.GroupName + "/auto-pod-group"
 )
 
 // PodGroup is a collection of Pod; used for batch workload.
@@ -117,6 +153,7 @@ const (
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:shortName={pg,pgs}
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:metadata:annotations="api-approved.kubernetes.io=https://github.com/kubernetes-sigs/scheduler-plugins/pull/50"
 // +kubebuilder:printcolumn:name="Phase",JSONPath=".status.phase",type=string,description="Current phase of PodGroup."
@@ -27,6 +27,13 @@ type ServerRunOptions struct {
 	ApiServerBurst       int
 	Workers              int
 	EnableLeaderElection bool
+
+	EnableWebhooks                        bool
+	WebhookPort                           int
+	WebhookCertDir                        string
+	PodGroupDefaultScheduleTimeoutSeconds int
+
+	EnablePodGroupAutoCreation bool
 }
 
 func NewServerRunOptions() *ServerRunOptions {
@@ -42,4 +49,9 @@ func (s *ServerRunOptions) addAllFlags() {
 	pflag.IntVar(&s.ApiServerBurst, "burst", 10, "burst of query apiserver.")
 	pflag.IntVar(&s.Workers, "workers", 1, "workers of scheduler-plugin-controllers.")
 	pflag.BoolVar(&s.EnableLeaderElection, "enableLeaderElection", s.EnableLeaderElection, "If EnableLeaderElection for controller.")
+	pflag.BoolVar(&s.EnableWebhooks, "enableWebhooks", s.EnableWebhooks, "If the controller serves the PodGroup and ElasticQuota admission webhooks.")
+	pflag.IntVar(&s.WebhookPort, "webhookPort", 9443, "Port the admission webhook server listens on.")
+	pflag.StringVar(&s.WebhookCertDir, "webhookCertDir", "", "Directory containing tls.crt and tls.key for the webhook server. Defaults to <tmp>/k8s-webhook-server/serving-certs.")
+	pflag.BoolVar(&s.EnablePodGroupAutoCreation, "enablePodGroupAutoCreation", s.EnablePodGroupAutoCreation, "If the controller creates PodGroups for opted-in Jobs, StatefulSets, ReplicaSets and JobSets, and labels their pods when webhooks are enabled.")
+	pflag.IntVar(&s.PodGroupDefaultScheduleTimeoutSeconds, "podGroupDefaultScheduleTimeoutSeconds", 0, "ScheduleTimeoutSeconds set on PodGroups that do not specify one; 0 leaves it unset.")
 }
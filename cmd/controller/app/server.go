@@ -24,9 +24,12 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	schedulingv1a1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	schedulingv1b1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1beta1"
 	"sigs.k8s.io/scheduler-plugins/pkg/controllers"
+	"sigs.k8s.io/scheduler-plugins/pkg/webhooks"
 )
 
 var (
@@ -38,6 +41,9 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(schedulingv1a1.AddToScheme(scheme))
+	// Registering the hub version makes the webhook server serve /convert for
+	// the scheduling CRDs when webhooks are enabled.
+	utilruntime.Must(schedulingv1b1.AddToScheme(scheme))
 }
 
 func Run(s *ServerRunOptions) error {
@@ -52,6 +58,10 @@ func Run(s *ServerRunOptions) error {
 		Metrics: metricsserver.Options{
 			BindAddress: s.MetricsAddr,
 		},
+		WebhookServer: webhook.NewServer(webhook.Options{
+			Port:    s.WebhookPort,
+			CertDir: s.WebhookCertDir,
+		}),
 		HealthProbeBindAddress:  s.ProbeAddr,
 		LeaderElection:          s.EnableLeaderElection,
 		LeaderElectionID:        "sched-plugins-controllers",
@@ -80,6 +90,23 @@ func Run(s *ServerRunOptions) error {
 		return err
 	}
 
+	if s.EnablePodGroupAutoCreation {
+		if err = (&controllers.WorkloadReconciler{
+			Client:  mgr.GetClient(),
+			Scheme:  mgr.GetScheme(),
+			Workers: s.Workers,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Workload")
+			return err
+		}
+	}
+
+	if s.EnableWebhooks {
+		if err := setupWebhooks(mgr, s); err != nil {
+			return err
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		return err
@@ -95,3 +122,28 @@ func Run(s *ServerRunOptions) error {
 	}
 	return nil
 }
+
+func setupWebhooks(mgr ctrl.Manager, s *ServerRunOptions) error {
+	pgWebhook := &webhooks.PodGroupWebhook{}
+	if s.PodGroupDefaultScheduleTimeoutSeconds > 0 {
+		timeout := int32(s.PodGroupDefaultScheduleTimeoutSeconds)
+		pgWebhook.DefaultScheduleTimeoutSeconds = &timeout
+	}
+	if err := pgWebhook.SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "PodGroup")
+		return err
+	}
+
+	if err := (&webhooks.ElasticQuotaWebhook{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ElasticQuota")
+		return err
+	}
+
+	if s.EnablePodGroupAutoCreation {
+		if err := (&webhooks.PodWebhook{Client: mgr.GetClient(), APIReader: mgr.GetAPIReader()}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Pod")
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/server/mux"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	frameworkruntime "k8s.io/kubernetes/pkg/scheduler/framework/runtime"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/debug"
+)
+
+// SnapshotPath is the path of the secure port the snapshot of the state of the plugins is served on.
+const SnapshotPath = "/debug/scheduler-plugins/snapshot"
+
+// pluginStates records the plugins holding a state as the frameworks create them, by profile, so
+// that their state can be dumped and served while the scheduler runs.
+type pluginStates struct {
+	sync.Mutex
+	dumpers map[string][]debug.StateDumper
+	// handlers are the handlers of the plugins, by path.
+	handlers map[string]http.Handler
+}
+
+func newPluginStates() *pluginStates {
+	return &pluginStates{
+		dumpers:  make(map[string][]debug.StateDumper),
+		handlers: make(map[string]http.Handler),
+	}
+}
+
+// record wraps the plugin factory to record the plugins it creates.
+func (s *pluginStates) record(factory frameworkruntime.PluginFactory) frameworkruntime.PluginFactory {
+	return func(ctx context.Context, args runtime.Object, handle framework.Handle) (framework.Plugin, error) {
+		plugin, err := factory(ctx, args, handle)
+		if err != nil {
+			return nil, err
+		}
+		dumper, isDumper := plugin.(debug.StateDumper)
+		provider, isProvider := plugin.(debug.HandlerProvider)
+		if !isDumper && !isProvider {
+			return plugin, nil
+		}
+		// The handle is the framework of the profile, which is named before the plugins are created.
+		profile, ok := handle.(interface{ ProfileName() string })
+		if !ok {
+			klog.FromContext(ctx).Info("Unable to record the state of the plugin, unknown profile", "plugin", plugin.Name())
+			return plugin, nil
+		}
+		profileName := profile.ProfileName()
+		s.Lock()
+		defer s.Unlock()
+		if isDumper {
+			s.dumpers[profileName] = append(s.dumpers[profileName], dumper)
+		}
+		if isProvider {
+			for path, handler := range provider.DebugHandlers() {
+				s.handlers[debug.PluginPath(profileName, plugin.Name(), path)] = handler
+			}
+		}
+		return plugin, nil
+	}
+}
+
+// snapshot dumps the state of the recorded plugins.
+func (s *pluginStates) snapshot() *debug.Snapshot {
+	s.Lock()
+	defer s.Unlock()
+	snapshot := &debug.Snapshot{
+		Version:  debug.SnapshotVersion,
+		Time:     metav1.Now(),
+		Profiles: make(map[string]*debug.ProfileState, len(s.dumpers)),
+	}
+	for profile, dumpers := range s.dumpers {
+		state := &debug.ProfileState{}
+		for _, dumper := range dumpers {
+			dumper.DumpState(state)
+		}
+		snapshot.Profiles[profile] = state
+	}
+	return snapshot
+}
+
+// paths returns the paths of the handlers of the recorded plugins, sorted.
+func (s *pluginStates) paths() []string {
+	s.Lock()
+	defer s.Unlock()
+	return sets.List(sets.KeySet(s.handlers))
+}
+
+// installDebugHandlers installs the handlers exposing the state of the plugins: the snapshot of all
+// of them, the handlers of each plugin and the index of these handlers. The plugins are created
+// before, along with the scheduler.
+func installDebugHandlers(pathRecorderMux *mux.PathRecorderMux, states *pluginStates) {
+	pathRecorderMux.Handle(SnapshotPath, debug.JSONHandler(func() interface{} { return states.snapshot() }))
+	pathRecorderMux.Handle(debug.PluginPathPrefix, debug.JSONHandler(func() interface{} { return states.paths() }))
+	states.Lock()
+	defer states.Unlock()
+	for path, handler := range states.handlers {
+		pathRecorderMux.Handle(path, handler)
+	}
+}
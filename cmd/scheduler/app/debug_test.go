@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/server/mux"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/debug"
+)
+
+// fakeHandle is the handle of the framework of a profile.
+type fakeHandle struct {
+	framework.Handle
+	profileName string
+}
+
+func (h *fakeHandle) ProfileName() string {
+	return h.profileName
+}
+
+type fakePlugin struct {
+	syscalls map[string][]string
+}
+
+func (pl *fakePlugin) Name() string {
+	return "fake"
+}
+
+func (pl *fakePlugin) DumpState(state *debug.ProfileState) {
+	state.SySched = &debug.SySchedState{HostSyscalls: pl.syscalls}
+}
+
+func (pl *fakePlugin) DebugHandlers() map[string]http.Handler {
+	return map[string]http.Handler{
+		"syscalls": debug.JSONHandler(func() interface{} { return pl.syscalls }),
+	}
+}
+
+type statelessPlugin struct{}
+
+func (pl *statelessPlugin) Name() string {
+	return "stateless"
+}
+
+func TestPluginStates(t *testing.T) {
+	states := newPluginStates()
+	newPlugin := func(plugin framework.Plugin) func(context.Context, runtime.Object, framework.Handle) (framework.Plugin, error) {
+		return states.record(func(context.Context, runtime.Object, framework.Handle) (framework.Plugin, error) {
+			return plugin, nil
+		})
+	}
+	ctx := context.Background()
+	if _, err := newPlugin(&fakePlugin{syscalls: map[string][]string{"node": {"read"}}})(ctx, nil, &fakeHandle{profileName: "scheduler-a"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newPlugin(&statelessPlugin{})(ctx, nil, &fakeHandle{profileName: "scheduler-b"}); err != nil {
+		t.Fatal(err)
+	}
+
+	pathRecorderMux := mux.NewPathRecorderMux("test")
+	installDebugHandlers(pathRecorderMux, states)
+	get := func(method, path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		pathRecorderMux.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+		return w
+	}
+
+	w := get(http.MethodGet, SnapshotPath)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Want status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	snapshot, err := debug.ReadSnapshot(w.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]*debug.ProfileState{
+		"scheduler-a": {SySched: &debug.SySchedState{HostSyscalls: map[string][]string{"node": {"read"}}}},
+	}
+	if diff := cmp.Diff(want, snapshot.Profiles); diff != "" {
+		t.Errorf("Unexpected profile states (-want,+got):\n%s", diff)
+	}
+
+	pluginPath := debug.PluginPath("scheduler-a", "fake", "syscalls")
+	var paths []string
+	if err := json.NewDecoder(get(http.MethodGet, debug.PluginPathPrefix).Body).Decode(&paths); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{pluginPath}, paths); diff != "" {
+		t.Errorf("Unexpected plugin paths (-want,+got):\n%s", diff)
+	}
+
+	var syscalls map[string][]string
+	if err := json.NewDecoder(get(http.MethodGet, pluginPath).Body).Decode(&syscalls); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string][]string{"node": {"read"}}, syscalls); diff != "" {
+		t.Errorf("Unexpected plugin state (-want,+got):\n%s", diff)
+	}
+
+	for _, path := range []string{SnapshotPath, pluginPath} {
+		if w := get(http.MethodPost, path); w.Code != http.StatusMethodNotAllowed {
+			t.Errorf("Want status %d posting to %s, got %d", http.StatusMethodNotAllowed, path, w.Code)
+		}
+	}
+}
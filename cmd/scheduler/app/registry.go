@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	frameworkruntime "k8s.io/kubernetes/pkg/scheduler/framework/runtime"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/capacityscheduling"
+	"sigs.k8s.io/scheduler-plugins/pkg/coscheduling"
+	"sigs.k8s.io/scheduler-plugins/pkg/networkaware/networkoverhead"
+	"sigs.k8s.io/scheduler-plugins/pkg/networkaware/topologicalsort"
+	"sigs.k8s.io/scheduler-plugins/pkg/noderesources"
+	"sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology"
+	"sigs.k8s.io/scheduler-plugins/pkg/podstate"
+	"sigs.k8s.io/scheduler-plugins/pkg/preemptiontoleration"
+	"sigs.k8s.io/scheduler-plugins/pkg/qos"
+	"sigs.k8s.io/scheduler-plugins/pkg/sysched"
+	"sigs.k8s.io/scheduler-plugins/pkg/trimaran/loadvariationriskbalancing"
+	"sigs.k8s.io/scheduler-plugins/pkg/trimaran/lowriskovercommitment"
+	"sigs.k8s.io/scheduler-plugins/pkg/trimaran/peaks"
+	"sigs.k8s.io/scheduler-plugins/pkg/trimaran/targetloadpacking"
+
+	// Ensure scheme package is initialized.
+	_ "sigs.k8s.io/scheduler-plugins/apis/config/scheme"
+)
+
+// OutOfTreeRegistry returns the custom plugins of the scheduler framework. Later they can consist
+// of scheduler profile(s) and hence used by various kinds of workloads.
+func OutOfTreeRegistry() frameworkruntime.Registry {
+	return frameworkruntime.Registry{
+		capacityscheduling.Name:         capacityscheduling.New,
+		coscheduling.Name:               coscheduling.New,
+		loadvariationriskbalancing.Name: loadvariationriskbalancing.New,
+		networkoverhead.Name:            networkoverhead.New,
+		topologicalsort.Name:            topologicalsort.New,
+		noderesources.AllocatableName:   noderesources.NewAllocatable,
+		noderesources.StrandedName:      noderesources.NewStranded,
+		noderesourcetopology.Name:       noderesourcetopology.New,
+		preemptiontoleration.Name:       preemptiontoleration.New,
+		targetloadpacking.Name:          targetloadpacking.New,
+		lowriskovercommitment.Name:      lowriskovercommitment.New,
+		sysched.Name:                    sysched.New,
+		peaks.Name:                      peaks.New,
+		// Sample plugins below.
+		// crossnodepreemption.Name: crossnodepreemption.New,
+		podstate.Name: podstate.New,
+		qos.Name:      qos.New,
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"runtime/debug"
+	"testing"
+)
+
+func TestForkedKubernetesVersion(t *testing.T) {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		t.Fatal("Failed to read the build info")
+	}
+	for _, dep := range info.Deps {
+		if dep.Path != "k8s.io/kubernetes" {
+			continue
+		}
+		version := dep.Version
+		if dep.Replace != nil {
+			version = dep.Replace.Version
+		}
+		if version != forkedKubernetesVersion {
+			t.Errorf("server.go is forked from k8s.io/kubernetes %s but the module requires %s, re-sync it with upstream", forkedKubernetesVersion, version)
+		}
+		return
+	}
+	t.Fatal("k8s.io/kubernetes is not a dependency")
+}
@@ -0,0 +1,537 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	topologyv1alpha2 "github.com/k8stopologyawareschedwg/noderesourcetopology-api/pkg/apis/topology/v1alpha2"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+
+	agv1alpha1 "github.com/diktyo-io/appgroup-api/pkg/apis/appgroup/v1alpha1"
+	ntv1alpha1 "github.com/diktyo-io/networktopology-api/pkg/apis/networktopology/v1alpha1"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+)
+
+// servedResource is a resource served by the fake API.
+type servedResource struct {
+	gvk        schema.GroupVersionKind
+	resource   string
+	namespaced bool
+}
+
+// servedResources are the resources the in-tree and out-of-tree plugins read. Requests for any other
+// resource are answered with NotFound.
+var servedResources = []servedResource{
+	{v1.SchemeGroupVersion.WithKind("Pod"), "pods", true},
+	{v1.SchemeGroupVersion.WithKind("Node"), "nodes", false},
+	{v1.SchemeGroupVersion.WithKind("Namespace"), "namespaces", false},
+	{v1.SchemeGroupVersion.WithKind("Service"), "services", true},
+	{v1.SchemeGroupVersion.WithKind("ConfigMap"), "configmaps", true},
+	{v1.SchemeGroupVersion.WithKind("ReplicationController"), "replicationcontrollers", true},
+	{v1.SchemeGroupVersion.WithKind("PersistentVolume"), "persistentvolumes", false},
+	{v1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"), "persistentvolumeclaims", true},
+	{v1.SchemeGroupVersion.WithKind("Event"), "events", true},
+	{schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"}, "replicasets", true},
+	{schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}, "statefulsets", true},
+	{schema.GroupVersionKind{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget"}, "poddisruptionbudgets", true},
+	{schema.GroupVersionKind{Group: "storage.k8s.io", Version: "v1", Kind: "StorageClass"}, "storageclasses", false},
+	{schema.GroupVersionKind{Group: "storage.k8s.io", Version: "v1", Kind: "CSINode"}, "csinodes", false},
+	{schema.GroupVersionKind{Group: "storage.k8s.io", Version: "v1", Kind: "CSIDriver"}, "csidrivers", false},
+	{schema.GroupVersionKind{Group: "storage.k8s.io", Version: "v1", Kind: "CSIStorageCapacity"}, "csistoragecapacities", true},
+	{schema.GroupVersionKind{Group: "storage.k8s.io", Version: "v1", Kind: "VolumeAttachment"}, "volumeattachments", false},
+	{v1alpha1.SchemeGroupVersion.WithKind("PodGroup"), "podgroups", true},
+	{v1alpha1.SchemeGroupVersion.WithKind("ElasticQuota"), "elasticquotas", true},
+	{topologyv1alpha2.SchemeGroupVersion.WithKind("NodeResourceTopology"), "noderesourcetopologies", false},
+	{agv1alpha1.SchemeGroupVersion.WithKind("AppGroup"), "appgroups", true},
+	{ntv1alpha1.SchemeGroupVersion.WithKind("NetworkTopology"), "networktopologies", true},
+	{v1beta1.GroupVersion.WithKind("SeccompProfile"), "seccompprofiles", true},
+}
+
+// versionedTracker fills in the metadata the API server would set on the objects it stores: a
+// resource version for every write, and a UID and creation timestamp for new objects.
+type versionedTracker struct {
+	clienttesting.ObjectTracker
+
+	lock    sync.Mutex
+	version uint64
+}
+
+func (t *versionedTracker) nextVersion(obj runtime.Object, created bool) error {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	t.lock.Lock()
+	t.version++
+	accessor.SetResourceVersion(strconv.FormatUint(t.version, 10))
+	t.lock.Unlock()
+	if created {
+		if len(accessor.GetUID()) == 0 {
+			accessor.SetUID(uuid.NewUUID())
+		}
+		if timestamp := accessor.GetCreationTimestamp(); timestamp.IsZero() {
+			accessor.SetCreationTimestamp(metav1.Now())
+		}
+	}
+	return nil
+}
+
+func (t *versionedTracker) currentVersion() string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return strconv.FormatUint(t.version, 10)
+}
+
+func (t *versionedTracker) Add(obj runtime.Object) error {
+	if err := t.nextVersion(obj, true); err != nil {
+		return err
+	}
+	return t.ObjectTracker.Add(obj)
+}
+
+func (t *versionedTracker) Create(gvr schema.GroupVersionResource, obj runtime.Object, ns string, opts ...metav1.CreateOptions) error {
+	if err := t.nextVersion(obj, true); err != nil {
+		return err
+	}
+	return t.ObjectTracker.Create(gvr, obj, ns, opts...)
+}
+
+func (t *versionedTracker) Update(gvr schema.GroupVersionResource, obj runtime.Object, ns string, opts ...metav1.UpdateOptions) error {
+	if err := t.nextVersion(obj, false); err != nil {
+		return err
+	}
+	return t.ObjectTracker.Update(gvr, obj, ns, opts...)
+}
+
+func (t *versionedTracker) Patch(gvr schema.GroupVersionResource, obj runtime.Object, ns string, opts ...metav1.PatchOptions) error {
+	if err := t.nextVersion(obj, false); err != nil {
+		return err
+	}
+	return t.ObjectTracker.Patch(gvr, obj, ns, opts...)
+}
+
+// fakeAPI is a minimal API server backed by an in-memory object tracker. It serves discovery, the
+// get/list/watch/create/update/patch/delete verbs and pod bindings, which is what the informers and
+// clients used by the plugins need. Everything the plugins write stays in memory.
+type fakeAPI struct {
+	scheme      *runtime.Scheme
+	tracker     *versionedTracker
+	reaction    clienttesting.ReactionFunc
+	requestInfo *request.RequestInfoFactory
+	resources   map[schema.GroupVersionResource]servedResource
+	server      *httptest.Server
+
+	lock sync.Mutex
+	// deletedPods records the pods deleted through the API, e.g. preemption victims, until taken.
+	deletedPods []string
+}
+
+func newFakeAPI(scheme *runtime.Scheme, decoder runtime.Decoder) *fakeAPI {
+	tracker := &versionedTracker{ObjectTracker: clienttesting.NewObjectTracker(scheme, decoder)}
+	a := &fakeAPI{
+		scheme:   scheme,
+		tracker:  tracker,
+		reaction: clienttesting.ObjectReaction(tracker),
+		requestInfo: &request.RequestInfoFactory{
+			APIPrefixes:          sets.NewString("api", "apis"),
+			GrouplessAPIPrefixes: sets.NewString("api"),
+		},
+		resources: make(map[schema.GroupVersionResource]servedResource),
+	}
+	for _, r := range servedResources {
+		a.resources[r.gvk.GroupVersion().WithResource(r.resource)] = r
+	}
+	a.server = httptest.NewServer(a)
+	return a
+}
+
+// URL returns the address of the fake API.
+func (a *fakeAPI) URL() string {
+	return a.server.URL
+}
+
+// Close shuts the fake API down.
+func (a *fakeAPI) Close() {
+	a.server.CloseClientConnections()
+	a.server.Close()
+}
+
+// takeDeletedPods returns the pods deleted since the last call, as namespace/name.
+func (a *fakeAPI) takeDeletedPods() []string {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	deleted := a.deletedPods
+	a.deletedPods = nil
+	return deleted
+}
+
+func (a *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	info, err := a.requestInfo.NewRequestInfo(r)
+	if err != nil {
+		a.writeError(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	if !info.IsResourceRequest {
+		a.serveDiscovery(w, r)
+		return
+	}
+	gvr := schema.GroupVersionResource{Group: info.APIGroup, Version: info.APIVersion, Resource: info.Resource}
+	res, ok := a.resources[gvr]
+	if !ok {
+		a.writeError(w, apierrors.NewNotFound(gvr.GroupResource(), info.Name))
+		return
+	}
+
+	switch info.Verb {
+	case "list":
+		a.list(w, r, res, gvr, info.Namespace)
+	case "watch":
+		a.watch(w, r, gvr, info.Namespace)
+	case "get":
+		a.react(w, http.StatusOK, clienttesting.NewGetAction(gvr, info.Namespace, info.Name))
+	case "create":
+		if gvr.Resource == "pods" && info.Subresource == "binding" {
+			a.bind(w, r, gvr, info.Namespace, info.Name)
+			return
+		}
+		obj, err := a.decode(r, res)
+		if err != nil {
+			a.writeError(w, apierrors.NewBadRequest(err.Error()))
+			return
+		}
+		a.react(w, http.StatusCreated, clienttesting.NewCreateSubresourceAction(gvr, info.Name, info.Subresource, info.Namespace, obj))
+	case "update":
+		obj, err := a.decode(r, res)
+		if err != nil {
+			a.writeError(w, apierrors.NewBadRequest(err.Error()))
+			return
+		}
+		a.react(w, http.StatusOK, clienttesting.NewUpdateSubresourceAction(gvr, info.Subresource, info.Namespace, obj))
+	case "patch":
+		patch, err := io.ReadAll(r.Body)
+		if err != nil {
+			a.writeError(w, apierrors.NewBadRequest(err.Error()))
+			return
+		}
+		patchType := types.PatchType(r.Header.Get("Content-Type"))
+		a.react(w, http.StatusOK, clienttesting.NewPatchSubresourceAction(gvr, info.Namespace, info.Name, patchType, patch, info.Subresource))
+	case "delete":
+		if err := a.tracker.Delete(gvr, info.Namespace, info.Name); err != nil {
+			a.writeError(w, err)
+			return
+		}
+		if gvr.Resource == "pods" {
+			a.lock.Lock()
+			a.deletedPods = append(a.deletedPods, info.Namespace+"/"+info.Name)
+			a.lock.Unlock()
+		}
+		a.writeObject(w, http.StatusOK, &metav1.Status{Status: metav1.StatusSuccess})
+	default:
+		a.writeError(w, apierrors.NewMethodNotSupported(gvr.GroupResource(), info.Verb))
+	}
+}
+
+// serveDiscovery answers the legacy discovery requests with the served resources.
+func (a *fakeAPI) serveDiscovery(w http.ResponseWriter, r *http.Request) {
+	groupVersions := map[schema.GroupVersion][]metav1.APIResource{}
+	for _, res := range servedResources {
+		gv := res.gvk.GroupVersion()
+		groupVersions[gv] = append(groupVersions[gv], metav1.APIResource{
+			Name:       res.resource,
+			Namespaced: res.namespaced,
+			Kind:       res.gvk.Kind,
+			Verbs:      metav1.Verbs{"get", "list", "watch", "create", "update", "patch", "delete"},
+		})
+	}
+
+	switch r.URL.Path {
+	case "/api":
+		a.writeObject(w, http.StatusOK, &metav1.APIVersions{
+			TypeMeta: metav1.TypeMeta{Kind: "APIVersions"},
+			Versions: []string{"v1"},
+		})
+		return
+	case "/apis":
+		list := &metav1.APIGroupList{TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"}}
+		for gv := range groupVersions {
+			if gv.Group == "" {
+				continue
+			}
+			version := metav1.GroupVersionForDiscovery{GroupVersion: gv.String(), Version: gv.Version}
+			list.Groups = append(list.Groups, metav1.APIGroup{
+				Name:             gv.Group,
+				Versions:         []metav1.GroupVersionForDiscovery{version},
+				PreferredVersion: version,
+			})
+		}
+		sort.Slice(list.Groups, func(i, j int) bool { return list.Groups[i].Name < list.Groups[j].Name })
+		a.writeObject(w, http.StatusOK, list)
+		return
+	}
+	for gv, resources := range groupVersions {
+		path := "/apis/" + gv.String()
+		if gv.Group == "" {
+			path = "/api/" + gv.Version
+		}
+		if r.URL.Path == path {
+			a.writeObject(w, http.StatusOK, &metav1.APIResourceList{
+				TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+				GroupVersion: gv.String(),
+				APIResources: resources,
+			})
+			return
+		}
+	}
+	a.writeError(w, apierrors.NewNotFound(schema.GroupResource{}, r.URL.Path))
+}
+
+func (a *fakeAPI) list(w http.ResponseWriter, r *http.Request, res servedResource, gvr schema.GroupVersionResource, namespace string) {
+	labelSelector, fieldSelector, err := parseSelectors(r)
+	if err != nil {
+		a.writeError(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	version := a.tracker.currentVersion()
+	list, err := a.tracker.List(gvr, res.gvk, namespace)
+	if err != nil {
+		a.writeError(w, err)
+		return
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		a.writeError(w, err)
+		return
+	}
+	var matching []runtime.Object
+	for _, item := range items {
+		if matches(item, labelSelector, fieldSelector) {
+			matching = append(matching, item)
+		}
+	}
+	if err := meta.SetList(list, matching); err != nil {
+		a.writeError(w, err)
+		return
+	}
+	if listAccessor, err := meta.ListAccessor(list); err == nil {
+		listAccessor.SetResourceVersion(version)
+	}
+	a.writeObject(w, http.StatusOK, list)
+}
+
+func (a *fakeAPI) watch(w http.ResponseWriter, r *http.Request, gvr schema.GroupVersionResource, namespace string) {
+	labelSelector, fieldSelector, err := parseSelectors(r)
+	if err != nil {
+		a.writeError(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	timeout := time.Hour
+	if seconds := r.URL.Query().Get("timeoutSeconds"); seconds != "" {
+		if s, err := strconv.Atoi(seconds); err == nil && s > 0 {
+			timeout = time.Duration(s) * time.Second
+		}
+	}
+	watcher, err := a.tracker.Watch(gvr, namespace)
+	if err != nil {
+		a.writeError(w, err)
+		return
+	}
+	// The tracker panics when the events of a watcher are not consumed, so always stop it.
+	defer watcher.Stop()
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		a.writeError(w, apierrors.NewInternalError(fmt.Errorf("streaming is not supported")))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	encoder := json.NewEncoder(w)
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-timer.C:
+			return
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return
+			}
+			if event.Type != watch.Deleted && !matches(event.Object, labelSelector, fieldSelector) {
+				continue
+			}
+			raw, err := a.encode(event.Object)
+			if err != nil {
+				return
+			}
+			if err := encoder.Encode(&metav1.WatchEvent{Type: string(event.Type), Object: runtime.RawExtension{Raw: raw}}); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// bind assigns the pod to the node of the binding. As no kubelet runs, the pod is marked running
+// right away.
+func (a *fakeAPI) bind(w http.ResponseWriter, r *http.Request, gvr schema.GroupVersionResource, namespace, name string) {
+	binding := &v1.Binding{}
+	if err := json.NewDecoder(r.Body).Decode(binding); err != nil {
+		a.writeError(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	obj, err := a.tracker.Get(gvr, namespace, name)
+	if err != nil {
+		a.writeError(w, err)
+		return
+	}
+	pod := obj.(*v1.Pod)
+	if len(pod.Spec.NodeName) != 0 {
+		a.writeError(w, apierrors.NewConflict(gvr.GroupResource(), name, fmt.Errorf("pod %v is already assigned to node %q", name, pod.Spec.NodeName)))
+		return
+	}
+	pod.Spec.NodeName = binding.Target.Name
+	pod.Status.Phase = v1.PodRunning
+	pod.Status.Conditions = append(pod.Status.Conditions, v1.PodCondition{
+		Type:               v1.PodScheduled,
+		Status:             v1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+	})
+	if err := a.tracker.Update(gvr, pod, namespace); err != nil {
+		a.writeError(w, err)
+		return
+	}
+	a.writeObject(w, http.StatusCreated, &metav1.Status{Status: metav1.StatusSuccess})
+}
+
+func (a *fakeAPI) react(w http.ResponseWriter, code int, action clienttesting.Action) {
+	_, obj, err := a.reaction(action)
+	if err != nil {
+		a.writeError(w, err)
+		return
+	}
+	a.writeObject(w, code, obj)
+}
+
+// decode reads the object of the request body as the kind of the served resource.
+func (a *fakeAPI) decode(r *http.Request, res servedResource) (runtime.Object, error) {
+	obj, err := a.scheme.New(res.gvk)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.NewDecoder(r.Body).Decode(obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// encode serializes the object with its kind set, as clients expect.
+func (a *fakeAPI) encode(obj runtime.Object) ([]byte, error) {
+	if obj.GetObjectKind().GroupVersionKind().Empty() {
+		gvks, _, err := a.scheme.ObjectKinds(obj)
+		if err == nil && len(gvks) > 0 {
+			obj = obj.DeepCopyObject()
+			obj.GetObjectKind().SetGroupVersionKind(gvks[0])
+		}
+	}
+	return json.Marshal(obj)
+}
+
+func (a *fakeAPI) writeObject(w http.ResponseWriter, code int, obj runtime.Object) {
+	data, err := a.encode(obj)
+	if err != nil {
+		code = http.StatusInternalServerError
+		data, _ = json.Marshal(&apierrors.NewInternalError(err).ErrStatus)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(data)
+}
+
+func (a *fakeAPI) writeError(w http.ResponseWriter, err error) {
+	status, ok := err.(apierrors.APIStatus)
+	if !ok {
+		status = apierrors.NewInternalError(err)
+	}
+	s := status.Status()
+	s.TypeMeta = metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}
+	a.writeObject(w, int(s.Code), &s)
+}
+
+func parseSelectors(r *http.Request) (labels.Selector, fields.Selector, error) {
+	labelSelector, err := labels.Parse(r.URL.Query().Get("labelSelector"))
+	if err != nil {
+		return nil, nil, err
+	}
+	fieldSelector, err := fields.ParseSelector(r.URL.Query().Get("fieldSelector"))
+	if err != nil {
+		return nil, nil, err
+	}
+	return labelSelector, fieldSelector, nil
+}
+
+// matches returns true if the object matches the selectors. Field selectors support the metadata
+// name and namespace, and for pods the node name, scheduler name and phase.
+func matches(obj runtime.Object, labelSelector labels.Selector, fieldSelector fields.Selector) bool {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+	if !labelSelector.Matches(labels.Set(accessor.GetLabels())) {
+		return false
+	}
+	if fieldSelector.Empty() {
+		return true
+	}
+	set := fields.Set{
+		"metadata.name":      accessor.GetName(),
+		"metadata.namespace": accessor.GetNamespace(),
+	}
+	if pod, ok := obj.(*v1.Pod); ok {
+		set["spec.nodeName"] = pod.Spec.NodeName
+		set["spec.schedulerName"] = pod.Spec.SchedulerName
+		set["status.phase"] = string(pod.Status.Phase)
+	}
+	return fieldSelector.Matches(set)
+}
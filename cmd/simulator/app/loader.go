@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	topologyv1alpha2 "github.com/k8stopologyawareschedwg/noderesourcetopology-api/pkg/apis/topology/v1alpha2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+
+	agv1alpha1 "github.com/diktyo-io/appgroup-api/pkg/apis/appgroup/v1alpha1"
+	ntv1alpha1 "github.com/diktyo-io/networktopology-api/pkg/apis/networktopology/v1alpha1"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+)
+
+var (
+	scheme = runtime.NewScheme()
+	codecs = serializer.NewCodecFactory(scheme)
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	utilruntime.Must(topologyv1alpha2.AddToScheme(scheme))
+	utilruntime.Must(agv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(ntv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(v1beta1.AddToScheme(scheme))
+}
+
+// loadSnapshot reads the objects of the cluster snapshot from the given YAML or JSON files, or from
+// the .yaml, .yml and .json files of the given directories. A file may hold several documents,
+// and lists are expanded into their items. The objects are returned in the order they are read.
+func loadSnapshot(paths []string) ([]runtime.Object, error) {
+	var objs []runtime.Object
+	for _, path := range paths {
+		files, err := snapshotFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			fileObjs, err := loadSnapshotFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("loading %s: %w", file, err)
+			}
+			objs = append(objs, fileObjs...)
+		}
+	}
+	return completeSnapshot(objs), nil
+}
+
+func snapshotFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		switch filepath.Ext(entry.Name()) {
+		case ".yaml", ".yml", ".json":
+			if !entry.IsDir() {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func loadSnapshotFile(file string) ([]runtime.Object, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var objs []runtime.Object
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(f))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return objs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		docObjs, err := decodeSnapshotObject(doc)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, docObjs...)
+	}
+}
+
+func decodeSnapshotObject(data []byte) ([]runtime.Object, error) {
+	data, err := utilyaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	obj, _, err := codecs.UniversalDeserializer().Decode(data, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !meta.IsListType(obj) {
+		return []runtime.Object{obj}, nil
+	}
+	// Generic lists hold raw items which are decoded in turn.
+	if list, ok := obj.(*v1.List); ok {
+		var objs []runtime.Object
+		for _, item := range list.Items {
+			itemObjs, err := decodeSnapshotObject(item.Raw)
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, itemObjs...)
+		}
+		return objs, nil
+	}
+	return meta.ExtractList(obj)
+}
+
+// completeSnapshot fills in what the snapshot may leave out: pods get the default scheduler and a
+// UID derived from their name when they have none, so that the output is reproducible, and the
+// namespaces of the objects are created unless listed.
+func completeSnapshot(objs []runtime.Object) []runtime.Object {
+	namespaces := sets.New[string]()
+	listed := sets.New[string]()
+	for _, obj := range objs {
+		if ns, ok := obj.(*v1.Namespace); ok {
+			listed.Insert(ns.Name)
+			continue
+		}
+		if pod, ok := obj.(*v1.Pod); ok {
+			if len(pod.Spec.SchedulerName) == 0 {
+				pod.Spec.SchedulerName = v1.DefaultSchedulerName
+			}
+			if len(pod.UID) == 0 {
+				pod.UID = types.UID(pod.Namespace + "/" + pod.Name)
+			}
+		}
+		if accessor, err := meta.Accessor(obj); err == nil && len(accessor.GetNamespace()) != 0 {
+			namespaces.Insert(accessor.GetNamespace())
+		}
+	}
+	for _, name := range sets.List(namespaces.Difference(listed)) {
+		objs = append(objs, &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+	return objs
+}
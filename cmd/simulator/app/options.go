@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"github.com/spf13/pflag"
+)
+
+type SimulatorOptions struct {
+	ConfigFile    string
+	SnapshotPaths []string
+	OutputFile    string
+}
+
+func NewSimulatorOptions() *SimulatorOptions {
+	options := &SimulatorOptions{}
+	options.addAllFlags()
+	return options
+}
+
+func (s *SimulatorOptions) addAllFlags() {
+	pflag.StringVar(&s.ConfigFile, "config", "", "KubeSchedulerConfiguration file with the profiles and plugin args to simulate. Defaults to the default kube-scheduler configuration.")
+	pflag.StringSliceVar(&s.SnapshotPaths, "snapshot", nil, "YAML or JSON files, or directories of them, with the nodes, pods, NodeResourceTopologies, PodGroups, ElasticQuotas and other objects of the cluster. Pods without a node are scheduled.")
+	pflag.StringVar(&s.OutputFile, "output", "", "File the placement decisions are written to as JSON. Defaults to the standard output.")
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kubernetes/pkg/scheduler/apis/config"
+	"k8s.io/kubernetes/pkg/scheduler/apis/config/latest"
+	"k8s.io/kubernetes/pkg/scheduler/apis/config/validation"
+
+	schedulerscheme "sigs.k8s.io/scheduler-plugins/apis/config/scheme"
+)
+
+func Run(s *SimulatorOptions) error {
+	if len(s.SnapshotPaths) == 0 {
+		return fmt.Errorf("no cluster snapshot given, see --snapshot")
+	}
+	cfg, err := loadConfig(s.ConfigFile)
+	if err != nil {
+		return err
+	}
+	objs, err := loadSnapshot(s.SnapshotPaths)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	result, err := simulate(ctx, cfg, objs)
+	if err != nil {
+		return err
+	}
+
+	out := io.Writer(os.Stdout)
+	if s.OutputFile != "" {
+		f, err := os.Create(s.OutputFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+// simulate schedules the pending pods of the cluster snapshot with the given configuration.
+func simulate(ctx context.Context, cfg *config.KubeSchedulerConfiguration, objs []runtime.Object) (*Result, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s, err := newSimulator(ctx, cfg, objs)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	defer s.close()
+	defer cancel()
+	return s.simulate(ctx)
+}
+
+// loadConfig decodes and validates the scheduler configuration like the scheduler does, with the
+// plugin args of this repository registered.
+func loadConfig(file string) (*config.KubeSchedulerConfiguration, error) {
+	if file == "" {
+		return latest.Default()
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	obj, gvk, err := schedulerscheme.Codecs.UniversalDecoder().Decode(data, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	cfg, ok := obj.(*config.KubeSchedulerConfiguration)
+	if !ok {
+		return nil, fmt.Errorf("couldn't decode as KubeSchedulerConfiguration, got %s", gvk)
+	}
+	if err := validation.ValidateKubeSchedulerConfiguration(cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
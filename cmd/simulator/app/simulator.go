@@ -0,0 +1,522 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	clientset "k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/scheduler/apis/config"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	frameworkplugins "k8s.io/kubernetes/pkg/scheduler/framework/plugins"
+	frameworkruntime "k8s.io/kubernetes/pkg/scheduler/framework/runtime"
+
+	schedulerapp "sigs.k8s.io/scheduler-plugins/cmd/scheduler/app"
+	"sigs.k8s.io/scheduler-plugins/pkg/util"
+)
+
+// Status of a pod at the end of the simulation.
+const (
+	// PodScheduled means the pod was bound to a node.
+	PodScheduled = "Scheduled"
+	// PodUnschedulable means no node passed the filters, or a Reserve plugin rejected the pod.
+	PodUnschedulable = "Unschedulable"
+	// PodRejected means a Permit plugin rejected the pod, right away or while it was waiting.
+	PodRejected = "Rejected"
+	// PodError means a plugin failed while the pod was scheduled.
+	PodError = "Error"
+	// PodNoProfile means no profile of the configuration has the scheduler name of the pod, so the
+	// pod was left pending, as the scheduler would.
+	PodNoProfile = "NoProfile"
+)
+
+// Result is the outcome of a simulation.
+type Result struct {
+	// Pods are the results of the pending pods, in the order they were scheduled, followed by the
+	// pending pods no profile schedules, in the order of the snapshot.
+	Pods []*PodResult `json:"pods"`
+}
+
+// PodResult is the placement decision for a pending pod.
+type PodResult struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Profile is the scheduler profile which scheduled the pod, or the scheduler name of the pod if
+	// no profile has it.
+	Profile string `json:"profile"`
+	// Status is one of Scheduled, Unschedulable, Rejected, Error or NoProfile.
+	Status string `json:"status"`
+	// Node is the node the pod was bound to.
+	Node string `json:"node,omitempty"`
+	// NominatedNode is the node a PostFilter plugin nominated for the pod after preempting other pods.
+	NominatedNode string `json:"nominatedNode,omitempty"`
+	// Preempted are the pods deleted while the pod was scheduled, as namespace/name.
+	Preempted []string `json:"preempted,omitempty"`
+	// Message explains why the pod was not scheduled.
+	Message string `json:"message,omitempty"`
+	// Scores are the scores of the feasible nodes. They are left out when a single node was feasible,
+	// as the scheduler does not score nodes then.
+	Scores []NodeScore `json:"scores,omitempty"`
+}
+
+// NodeScore is the score of a feasible node for a pod.
+type NodeScore struct {
+	Node string `json:"node"`
+	// TotalScore is the weighted sum of the plugin scores.
+	TotalScore int64 `json:"totalScore"`
+	// Plugins are the normalized and weighted scores of each Score plugin.
+	Plugins map[string]int64 `json:"plugins"`
+}
+
+// waitingPod is a pod a Permit plugin holds, e.g. a member of an incomplete gang.
+type waitingPod struct {
+	fwk    framework.Framework
+	state  *framework.CycleState
+	pod    *v1.Pod
+	result *PodResult
+	done   chan *framework.Status
+}
+
+// simulator schedules the pending pods of a cluster snapshot, one at a time in queue order, with
+// the plugins of the scheduler profiles. The cluster is served by a fake API, so that the plugins
+// run unmodified, and each pod is attempted once.
+type simulator struct {
+	api             *fakeAPI
+	informerFactory informers.SharedInformerFactory
+	podLister       corelisters.PodLister
+	nodeLister      corelisters.NodeLister
+	snapshot        *snapshot
+	frameworks      map[string]framework.Framework
+	// order is the position of the pods in the snapshot, by UID.
+	order map[types.UID]int
+
+	// assumed are the pods reserved on a node but not bound yet, by UID.
+	assumed map[types.UID]*v1.Pod
+	waiting []*waitingPod
+}
+
+func newSimulator(ctx context.Context, cfg *config.KubeSchedulerConfiguration, objs []runtime.Object) (*simulator, error) {
+	api := newFakeAPI(scheme, codecs.UniversalDecoder())
+	for _, obj := range objs {
+		if err := api.tracker.Add(obj); err != nil {
+			api.Close()
+			return nil, err
+		}
+	}
+
+	// The fake API answers in memory, so there is no need to throttle the clients.
+	kubeConfig := &restclient.Config{Host: api.URL(), QPS: 10000, Burst: 10000}
+	client, err := clientset.NewForConfig(kubeConfig)
+	if err != nil {
+		api.Close()
+		return nil, err
+	}
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	s := &simulator{
+		api:             api,
+		informerFactory: informerFactory,
+		podLister:       informerFactory.Core().V1().Pods().Lister(),
+		nodeLister:      informerFactory.Core().V1().Nodes().Lister(),
+		snapshot:        newSnapshot(),
+		frameworks:      make(map[string]framework.Framework),
+		order:           make(map[types.UID]int),
+		assumed:         make(map[types.UID]*v1.Pod),
+	}
+	for i, obj := range objs {
+		if pod, ok := obj.(*v1.Pod); ok {
+			s.order[pod.UID] = i
+		}
+	}
+
+	registry := frameworkplugins.NewInTreeRegistry()
+	if err := registry.Merge(schedulerapp.OutOfTreeRegistry()); err != nil {
+		api.Close()
+		return nil, err
+	}
+	waitingPods := frameworkruntime.NewWaitingPodsMap()
+	for i := range cfg.Profiles {
+		profile := &cfg.Profiles[i]
+		fwk, err := frameworkruntime.NewFramework(ctx, registry, profile,
+			frameworkruntime.WithClientSet(client),
+			frameworkruntime.WithKubeConfig(kubeConfig),
+			frameworkruntime.WithInformerFactory(informerFactory),
+			frameworkruntime.WithSnapshotSharedLister(s.snapshot),
+			frameworkruntime.WithPodNominator(nominator{}),
+			frameworkruntime.WithWaitingPods(waitingPods),
+			frameworkruntime.WithEventRecorder(&events.FakeRecorder{}),
+			frameworkruntime.WithParallelism(int(cfg.Parallelism)),
+			frameworkruntime.WithLogger(klog.FromContext(ctx)),
+		)
+		if err != nil {
+			api.Close()
+			return nil, fmt.Errorf("initializing profile %q: %w", profile.SchedulerName, err)
+		}
+		s.frameworks[profile.SchedulerName] = fwk
+	}
+
+	informerFactory.Start(ctx.Done())
+	for informer, synced := range informerFactory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			api.Close()
+			return nil, fmt.Errorf("syncing the informer of %v", informer)
+		}
+	}
+	return s, nil
+}
+
+// close stops the fake API. The informers and plugins stop with the context passed to newSimulator.
+func (s *simulator) close() {
+	s.informerFactory.Shutdown()
+	s.api.Close()
+}
+
+// simulate schedules the pending pods of the snapshot.
+func (s *simulator) simulate(ctx context.Context) (*Result, error) {
+	queue, unscheduled, err := s.pendingPods()
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{Pods: []*PodResult{}}
+	for _, pod := range queue {
+		fwk := s.frameworks[pod.Spec.SchedulerName]
+		podResult, err := s.schedulePod(ctx, fwk, pod)
+		if err != nil {
+			return nil, err
+		}
+		podResult.Preempted = s.api.takeDeletedPods()
+		result.Pods = append(result.Pods, podResult)
+		if err := s.resolveWaitingPods(ctx, false); err != nil {
+			return nil, err
+		}
+	}
+	// The pods still waiting, e.g. members of gangs which never reached their minimum, are rejected
+	// as their timeout would have expired.
+	if err := s.resolveWaitingPods(ctx, true); err != nil {
+		return nil, err
+	}
+	for _, pod := range unscheduled {
+		result.Pods = append(result.Pods, &PodResult{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			Profile:   pod.Spec.SchedulerName,
+			Status:    PodNoProfile,
+			Message:   fmt.Sprintf("no profile with scheduler name %q", pod.Spec.SchedulerName),
+		})
+	}
+	return result, nil
+}
+
+// pendingPods returns the pods to schedule, sorted by the QueueSort plugin, and the pending pods no
+// profile schedules. The pods enter the queue in the order of the snapshot.
+func (s *simulator) pendingPods() ([]*v1.Pod, []*v1.Pod, error) {
+	pods, err := s.podLister.List(labels.Everything())
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(pods, func(i, j int) bool { return s.order[pods[i].UID] < s.order[pods[j].UID] })
+
+	start := time.Now()
+	var less framework.LessFunc
+	var queue []*framework.QueuedPodInfo
+	var unscheduled []*v1.Pod
+	for _, pod := range pods {
+		if len(pod.Spec.NodeName) != 0 || pod.DeletionTimestamp != nil || util.IsPodTerminated(pod) {
+			continue
+		}
+		fwk, ok := s.frameworks[pod.Spec.SchedulerName]
+		if !ok {
+			unscheduled = append(unscheduled, pod)
+			continue
+		}
+		// All the profiles share the same QueueSort plugin.
+		less = fwk.QueueSortFunc()
+		podInfo, err := framework.NewPodInfo(pod)
+		if err != nil {
+			return nil, nil, err
+		}
+		timestamp := start.Add(time.Duration(len(queue)) * time.Millisecond)
+		queue = append(queue, &framework.QueuedPodInfo{PodInfo: podInfo, Timestamp: timestamp, InitialAttemptTimestamp: &timestamp})
+	}
+	if less != nil {
+		sort.SliceStable(queue, func(i, j int) bool { return less(queue[i], queue[j]) })
+	}
+	sorted := make([]*v1.Pod, 0, len(queue))
+	for _, podInfo := range queue {
+		sorted = append(sorted, podInfo.Pod)
+	}
+	return sorted, unscheduled, nil
+}
+
+// schedulePod runs a scheduling cycle for the pod, and binds it unless a Permit plugin holds it.
+// Only failures of the simulation itself are returned as errors; failures of the plugins are
+// reported in the result.
+func (s *simulator) schedulePod(ctx context.Context, fwk framework.Framework, pod *v1.Pod) (*PodResult, error) {
+	result := &PodResult{Namespace: pod.Namespace, Name: pod.Name, Profile: fwk.ProfileName()}
+	if err := s.updateSnapshot(); err != nil {
+		return nil, err
+	}
+
+	state := framework.NewCycleState()
+	host, scores, err := s.findNode(ctx, fwk, state, pod)
+	result.Scores = scores
+	if err != nil {
+		var fitErr *framework.FitError
+		if !errors.As(err, &fitErr) {
+			result.Status, result.Message = PodError, err.Error()
+			return result, nil
+		}
+		postFilterResult, status := fwk.RunPostFilterPlugins(ctx, state, pod, fitErr.Diagnosis.NodeToStatusMap)
+		if status.Code() == framework.Error {
+			result.Status, result.Message = PodError, status.Message()
+			return result, nil
+		}
+		if !status.IsSuccess() {
+			fitErr.Diagnosis.PostFilterMsg = status.Message()
+		}
+		if postFilterResult != nil && postFilterResult.NominatingInfo != nil {
+			result.NominatedNode = postFilterResult.NominatedNodeName
+		}
+		result.Status, result.Message = PodUnschedulable, fitErr.Error()
+		return result, nil
+	}
+
+	assumedPod := pod.DeepCopy()
+	assumedPod.Spec.NodeName = host
+	s.assumed[assumedPod.UID] = assumedPod
+
+	if status := fwk.RunReservePluginsReserve(ctx, state, assumedPod, host); !status.IsSuccess() {
+		s.forget(ctx, fwk, state, assumedPod)
+		result.Status, result.Message = statusOf(status, PodUnschedulable), status.Message()
+		return result, nil
+	}
+	status := fwk.RunPermitPlugins(ctx, state, assumedPod, host)
+	if status.IsWait() {
+		w := &waitingPod{fwk: fwk, state: state, pod: assumedPod, result: result, done: make(chan *framework.Status, 1)}
+		go func() {
+			w.done <- fwk.WaitOnPermit(ctx, assumedPod)
+		}()
+		s.waiting = append(s.waiting, w)
+		return result, nil
+	}
+	if !status.IsSuccess() {
+		s.forget(ctx, fwk, state, assumedPod)
+		result.Status, result.Message = statusOf(status, PodRejected), status.Message()
+		return result, nil
+	}
+	return result, s.bind(ctx, fwk, state, assumedPod, result)
+}
+
+// findNode runs the PreFilter, Filter, PreScore and Score plugins, and returns the node with the
+// highest score. Unlike the scheduler, which picks one at random, ties are broken by node name so
+// that the simulation is reproducible. All the nodes are evaluated, whatever the
+// percentageOfNodesToScore.
+func (s *simulator) findNode(ctx context.Context, fwk framework.Framework, state *framework.CycleState, pod *v1.Pod) (string, []NodeScore, error) {
+	nodes, err := s.snapshot.List()
+	if err != nil {
+		return "", nil, err
+	}
+	fitError := &framework.FitError{
+		Pod:         pod,
+		NumAllNodes: len(nodes),
+		Diagnosis:   framework.Diagnosis{NodeToStatusMap: make(framework.NodeToStatusMap)},
+	}
+	if len(nodes) == 0 {
+		return "", nil, fitError
+	}
+
+	preFilterResult, status, unschedulablePlugins := fwk.RunPreFilterPlugins(ctx, state, pod)
+	fitError.Diagnosis.UnschedulablePlugins = unschedulablePlugins
+	if !status.IsSuccess() {
+		if !status.IsRejected() {
+			return "", nil, status.AsError()
+		}
+		for _, node := range nodes {
+			fitError.Diagnosis.NodeToStatusMap[node.Node().Name] = status
+		}
+		fitError.Diagnosis.PreFilterMsg = status.Message()
+		return "", nil, fitError
+	}
+
+	var feasible []*framework.NodeInfo
+	for _, node := range nodes {
+		if !preFilterResult.AllNodes() && !preFilterResult.NodeNames.Has(node.Node().Name) {
+			continue
+		}
+		status := fwk.RunFilterPluginsWithNominatedPods(ctx, state, pod, node)
+		switch {
+		case status.IsSuccess():
+			feasible = append(feasible, node)
+		case status.Code() == framework.Error:
+			return "", nil, status.AsError()
+		default:
+			fitError.Diagnosis.NodeToStatusMap[node.Node().Name] = status
+			fitError.Diagnosis.AddPluginStatus(status)
+		}
+	}
+	if len(feasible) == 0 {
+		return "", nil, fitError
+	}
+	if len(feasible) == 1 {
+		return feasible[0].Node().Name, nil, nil
+	}
+
+	if status := fwk.RunPreScorePlugins(ctx, state, pod, feasible); !status.IsSuccess() {
+		return "", nil, status.AsError()
+	}
+	nodeScores, status := fwk.RunScorePlugins(ctx, state, pod, feasible)
+	if !status.IsSuccess() {
+		return "", nil, status.AsError()
+	}
+	var host string
+	var best int64
+	scores := make([]NodeScore, 0, len(nodeScores))
+	for i, nodeScore := range nodeScores {
+		score := NodeScore{Node: nodeScore.Name, TotalScore: nodeScore.TotalScore, Plugins: make(map[string]int64)}
+		for _, pluginScore := range nodeScore.Scores {
+			score.Plugins[pluginScore.Name] = pluginScore.Score
+		}
+		scores = append(scores, score)
+		if i == 0 || nodeScore.TotalScore > best {
+			host, best = nodeScore.Name, nodeScore.TotalScore
+		}
+	}
+	return host, scores, nil
+}
+
+// resolveWaitingPods binds the waiting pods a Permit plugin allowed, and forgets those it rejected.
+// Pods allowed during the last cycle are resolved right away. When final, the pods still waiting
+// are rejected.
+func (s *simulator) resolveWaitingPods(ctx context.Context, final bool) error {
+	var waiting []*waitingPod
+	for _, w := range s.waiting {
+		if final {
+			w.fwk.RejectWaitingPod(w.pod.UID)
+		}
+		var status *framework.Status
+		if wp := w.fwk.GetWaitingPod(w.pod.UID); final || wp == nil || len(wp.GetPendingPlugins()) == 0 {
+			status = <-w.done
+		} else {
+			select {
+			case status = <-w.done:
+			default:
+				waiting = append(waiting, w)
+				continue
+			}
+		}
+		if !status.IsSuccess() {
+			s.forget(ctx, w.fwk, w.state, w.pod)
+			w.result.Status, w.result.Message = statusOf(status, PodRejected), status.Message()
+			continue
+		}
+		if err := s.bind(ctx, w.fwk, w.state, w.pod, w.result); err != nil {
+			return err
+		}
+	}
+	s.waiting = waiting
+	return nil
+}
+
+// bind runs the PreBind and Bind plugins for the assumed pod, and waits for the binding to reach
+// the informers.
+func (s *simulator) bind(ctx context.Context, fwk framework.Framework, state *framework.CycleState, pod *v1.Pod, result *PodResult) error {
+	host := pod.Spec.NodeName
+	status := fwk.RunPreBindPlugins(ctx, state, pod, host)
+	if status.IsSuccess() {
+		status = fwk.RunBindPlugins(ctx, state, pod, host)
+	}
+	if !status.IsSuccess() {
+		s.forget(ctx, fwk, state, pod)
+		result.Status, result.Message = PodError, status.Message()
+		return nil
+	}
+	err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 30*time.Second, true, func(context.Context) (bool, error) {
+		bound, err := s.podLister.Pods(pod.Namespace).Get(pod.Name)
+		if err != nil {
+			return false, nil
+		}
+		return bound.Spec.NodeName == host, nil
+	})
+	if err != nil {
+		return fmt.Errorf("waiting for pod %s/%s to be bound: %w", pod.Namespace, pod.Name, err)
+	}
+	delete(s.assumed, pod.UID)
+	fwk.RunPostBindPlugins(ctx, state, pod, host)
+	result.Status, result.Node = PodScheduled, host
+	return nil
+}
+
+// forget unreserves the assumed pod.
+func (s *simulator) forget(ctx context.Context, fwk framework.Framework, state *framework.CycleState, pod *v1.Pod) {
+	fwk.RunReservePluginsUnreserve(ctx, state, pod, pod.Spec.NodeName)
+	delete(s.assumed, pod.UID)
+}
+
+// updateSnapshot refreshes the snapshot with the nodes and assigned pods of the informers, and the
+// assumed pods, as the scheduler does at the start of every cycle.
+func (s *simulator) updateSnapshot() error {
+	nodes, err := s.nodeLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	pods, err := s.podLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	var assigned []*v1.Pod
+	for _, pod := range pods {
+		if _, ok := s.assumed[pod.UID]; !ok && len(pod.Spec.NodeName) != 0 && !util.IsPodTerminated(pod) {
+			assigned = append(assigned, pod)
+		}
+	}
+	for _, pod := range s.assumed {
+		assigned = append(assigned, pod)
+	}
+	s.snapshot.update(nodes, assigned)
+	return nil
+}
+
+// statusOf returns the pod status matching the failed plugin status: PodError for errors, the
+// given status otherwise.
+func statusOf(status *framework.Status, rejected string) string {
+	if status.Code() == framework.Error {
+		return PodError
+	}
+	return rejected
+}
+
+// nominator ignores nominations: each pod is attempted once, so no pod is scheduled to a node it
+// was nominated for.
+type nominator struct{}
+
+func (nominator) AddNominatedPod(klog.Logger, *framework.PodInfo, *framework.NominatingInfo) {}
+func (nominator) DeleteNominatedPodIfExists(*v1.Pod)                                         {}
+func (nominator) UpdateNominatedPod(klog.Logger, *v1.Pod, *framework.PodInfo)                {}
+func (nominator) NominatedPodsForNode(string) []*framework.PodInfo                           { return nil }
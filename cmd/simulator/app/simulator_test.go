@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const coschedulingConfig = `
+apiVersion: kubescheduler.config.k8s.io/v1
+kind: KubeSchedulerConfiguration
+profiles:
+- schedulerName: default-scheduler
+  plugins:
+    queueSort:
+      enabled:
+      - name: Coscheduling
+      disabled:
+      - name: "*"
+    preFilter:
+      enabled:
+      - name: Coscheduling
+    postFilter:
+      enabled:
+      - name: Coscheduling
+    permit:
+      enabled:
+      - name: Coscheduling
+    reserve:
+      enabled:
+      - name: Coscheduling
+  pluginConfig:
+  - name: Coscheduling
+    args:
+      permitWaitingTimeSeconds: 10
+`
+
+const nodes = `
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Node
+  metadata:
+    name: node-a
+  status:
+    allocatable: {cpu: "4", memory: 8Gi, pods: "110"}
+- apiVersion: v1
+  kind: Node
+  metadata:
+    name: node-b
+  status:
+    allocatable: {cpu: "2", memory: 4Gi, pods: "110"}
+`
+
+func makePodGroup(name string, minMember int) string {
+	return fmt.Sprintf(`
+apiVersion: scheduling.x-k8s.io/v1alpha1
+kind: PodGroup
+metadata: {name: %s, namespace: default}
+spec: {minMember: %d}
+`, name, minMember)
+}
+
+func makePod(name, podGroup, nodeName, cpu string) string {
+	return fmt.Sprintf(`
+apiVersion: v1
+kind: Pod
+metadata: {name: %s, namespace: default, labels: {scheduling.x-k8s.io/pod-group: "%s"}}
+spec:
+  nodeName: "%s"
+  containers: [{name: c, image: i, resources: {requests: {cpu: "%s"}}}]
+`, name, podGroup, nodeName, cpu)
+}
+
+func TestSimulate(t *testing.T) {
+	tests := []struct {
+		name    string
+		objects []string
+		// want is the status and node of the pods, in scheduling order.
+		want []string
+	}{
+		{
+			name: "pods are scheduled to the node with the highest score",
+			objects: []string{
+				makePod("running", "", "node-a", "3"),
+				makePod("p1", "", "", "500m"),
+				makePod("p2", "", "", "1"),
+			},
+			want: []string{"p1 Scheduled node-b", "p2 Scheduled node-b"},
+		},
+		{
+			name: "gang is bound once all its members are permitted",
+			objects: []string{
+				makePodGroup("gang", 3),
+				makePod("gang-1", "gang", "", "1"),
+				makePod("gang-2", "gang", "", "1"),
+				makePod("gang-3", "gang", "", "1"),
+			},
+			want: []string{"gang-1 Scheduled node-a", "gang-2 Scheduled node-a", "gang-3 Scheduled node-b"},
+		},
+		{
+			name: "gang with too few members is unschedulable",
+			objects: []string{
+				makePodGroup("gang", 3),
+				makePod("gang-1", "gang", "", "1"),
+				makePod("gang-2", "gang", "", "1"),
+			},
+			want: []string{"gang-1 Unschedulable", "gang-2 Unschedulable"},
+		},
+		{
+			name: "waiting members are rejected when a member does not fit",
+			objects: []string{
+				makePodGroup("gang", 3),
+				makePod("gang-1", "gang", "", "1"),
+				makePod("gang-2", "gang", "", "1"),
+				makePod("gang-3", "gang", "", "8"),
+			},
+			want: []string{"gang-1 Rejected", "gang-2 Rejected", "gang-3 Unschedulable"},
+		},
+		{
+			name: "pods of another scheduler are reported after the scheduled ones",
+			objects: []string{
+				strings.Replace(makePod("other", "", "", "1"), "spec:\n", "spec:\n  schedulerName: other-scheduler\n", 1),
+				makePod("p1", "", "", "1"),
+			},
+			want: []string{"p1 Scheduled node-a", "other NoProfile"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configFile := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configFile, []byte(coschedulingConfig), 0600); err != nil {
+				t.Fatal(err)
+			}
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "nodes.yaml"), []byte(nodes), 0600); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(dir, "pods.yaml"), []byte(strings.Join(tt.objects, "---")), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := loadConfig(configFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			objs, err := loadSnapshot([]string{dir})
+			if err != nil {
+				t.Fatal(err)
+			}
+			result, err := simulate(context.Background(), cfg, objs)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, pod := range result.Pods {
+				got = append(got, strings.TrimSpace(strings.Join([]string{pod.Name, pod.Status, pod.Node}, " ")))
+				if pod.Status == PodScheduled && len(pod.Scores) != 0 {
+					for _, score := range pod.Scores {
+						if _, ok := score.Plugins["NodeResourcesFit"]; !ok {
+							t.Errorf("pod %s: missing NodeResourcesFit score for node %s: %v", pod.Name, score.Node, score.Plugins)
+						}
+					}
+				}
+				if pod.Status != PodScheduled && pod.Message == "" {
+					t.Errorf("pod %s: missing message", pod.Name)
+				}
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected placements (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
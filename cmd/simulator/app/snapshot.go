@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+// snapshot is the view of the nodes and their pods the plugins get during a scheduling cycle, like
+// the snapshot of the scheduler cache. It is refreshed in place, so that the frameworks keep
+// listing the latest one.
+type snapshot struct {
+	nodeInfos                        []*framework.NodeInfo
+	nodeInfoMap                      map[string]*framework.NodeInfo
+	havePodsWithAffinity             []*framework.NodeInfo
+	havePodsWithRequiredAntiAffinity []*framework.NodeInfo
+	usedPVCs                         sets.Set[string]
+}
+
+var _ framework.SharedLister = &snapshot{}
+
+func newSnapshot() *snapshot {
+	return &snapshot{
+		nodeInfoMap: make(map[string]*framework.NodeInfo),
+		usedPVCs:    sets.New[string](),
+	}
+}
+
+// update replaces the content of the snapshot with the given nodes and the pods assigned to them.
+func (s *snapshot) update(nodes []*v1.Node, pods []*v1.Pod) {
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+	s.nodeInfos = make([]*framework.NodeInfo, 0, len(nodes))
+	s.nodeInfoMap = make(map[string]*framework.NodeInfo, len(nodes))
+	for _, node := range nodes {
+		nodeInfo := framework.NewNodeInfo()
+		nodeInfo.SetNode(node)
+		s.nodeInfos = append(s.nodeInfos, nodeInfo)
+		s.nodeInfoMap[node.Name] = nodeInfo
+	}
+	for _, pod := range pods {
+		if nodeInfo, ok := s.nodeInfoMap[pod.Spec.NodeName]; ok {
+			nodeInfo.AddPod(pod)
+		}
+	}
+
+	s.havePodsWithAffinity, s.havePodsWithRequiredAntiAffinity = nil, nil
+	s.usedPVCs = sets.New[string]()
+	for _, nodeInfo := range s.nodeInfos {
+		if len(nodeInfo.PodsWithAffinity) > 0 {
+			s.havePodsWithAffinity = append(s.havePodsWithAffinity, nodeInfo)
+		}
+		if len(nodeInfo.PodsWithRequiredAntiAffinity) > 0 {
+			s.havePodsWithRequiredAntiAffinity = append(s.havePodsWithRequiredAntiAffinity, nodeInfo)
+		}
+		for key := range nodeInfo.PVCRefCounts {
+			s.usedPVCs.Insert(key)
+		}
+	}
+}
+
+// NodeInfos returns a NodeInfoLister.
+func (s *snapshot) NodeInfos() framework.NodeInfoLister {
+	return s
+}
+
+// StorageInfos returns a StorageInfoLister.
+func (s *snapshot) StorageInfos() framework.StorageInfoLister {
+	return s
+}
+
+// List returns the list of NodeInfos, sorted by node name.
+func (s *snapshot) List() ([]*framework.NodeInfo, error) {
+	return s.nodeInfos, nil
+}
+
+// HavePodsWithAffinityList returns the list of NodeInfos of nodes with pods with affinity terms.
+func (s *snapshot) HavePodsWithAffinityList() ([]*framework.NodeInfo, error) {
+	return s.havePodsWithAffinity, nil
+}
+
+// HavePodsWithRequiredAntiAffinityList returns the list of NodeInfos of nodes with pods with required
+// anti-affinity terms.
+func (s *snapshot) HavePodsWithRequiredAntiAffinityList() ([]*framework.NodeInfo, error) {
+	return s.havePodsWithRequiredAntiAffinity, nil
+}
+
+// Get returns the NodeInfo of the given node name.
+func (s *snapshot) Get(nodeName string) (*framework.NodeInfo, error) {
+	if nodeInfo, ok := s.nodeInfoMap[nodeName]; ok {
+		return nodeInfo, nil
+	}
+	return nil, fmt.Errorf("nodeinfo not found for node name %q", nodeName)
+}
+
+// IsPVCUsedByPods returns true if the PVC, keyed as namespace/name, is used by a scheduled pod.
+func (s *snapshot) IsPVCUsedByPods(key string) bool {
+	return s.usedPVCs.Has(key)
+}
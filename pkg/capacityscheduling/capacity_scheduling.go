@@ -19,6 +19,7 @@ package capacityscheduling
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"sort"
 	"sync"
 
@@ -28,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/informers"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	corelisters "k8s.io/client-go/listers/core/v1"
@@ -45,6 +47,8 @@ import (
 
 	"sigs.k8s.io/scheduler-plugins/apis/scheduling"
 	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/debug"
+	pluginmetrics "sigs.k8s.io/scheduler-plugins/pkg/metrics"
 	"sigs.k8s.io/scheduler-plugins/pkg/util"
 )
 
@@ -101,6 +105,8 @@ var _ framework.PreFilterPlugin = &CapacityScheduling{}
 var _ framework.PostFilterPlugin = &CapacityScheduling{}
 var _ framework.ReservePlugin = &CapacityScheduling{}
 var _ framework.EnqueueExtensions = &CapacityScheduling{}
+var _ debug.StateDumper = &CapacityScheduling{}
+var _ debug.HandlerProvider = &CapacityScheduling{}
 var _ preemption.Interface = &preemptor{}
 
 const (
@@ -117,6 +123,34 @@ func (c *CapacityScheduling) Name() string {
 	return Name
 }
 
+// DumpState records the accounting of the ElasticQuotas.
+func (c *CapacityScheduling) DumpState(state *debug.ProfileState) {
+	state.CapacityScheduling = c.quotaState()
+}
+
+// DebugHandlers serves the accounting of the ElasticQuotas.
+func (c *CapacityScheduling) DebugHandlers() map[string]http.Handler {
+	return map[string]http.Handler{
+		"quotas": debug.JSONHandler(func() interface{} { return c.quotaState() }),
+	}
+}
+
+func (c *CapacityScheduling) quotaState() *debug.CapacitySchedulingState {
+	c.RLock()
+	defer c.RUnlock()
+	quotas := make(map[string]*debug.ElasticQuotaState, len(c.elasticQuotaInfos))
+	for namespace, info := range c.elasticQuotaInfos {
+		quotas[namespace] = &debug.ElasticQuotaState{
+			Name: info.Name,
+			Min:  info.Min.Clone(),
+			Max:  info.Max.Clone(),
+			Used: info.Used.Clone(),
+			Pods: sets.List(info.pods),
+		}
+	}
+	return &debug.CapacitySchedulingState{ElasticQuotas: quotas}
+}
+
 // New initializes a new plugin and returns it.
 func New(ctx context.Context, obj runtime.Object, handle framework.Handle) (framework.Plugin, error) {
 	c := &CapacityScheduling{
@@ -171,10 +205,10 @@ func New(ctx context.Context, obj runtime.Object, handle framework.Handle) (fram
 			FilterFunc: func(obj interface{}) bool {
 				switch t := obj.(type) {
 				case *v1.Pod:
-					return assignedPod(t)
+					return util.PodCountsInQuota(t)
 				case cache.DeletedFinalStateUnknown:
 					if pod, ok := t.Obj.(*v1.Pod); ok {
-						return assignedPod(pod)
+						return util.PodCountsInQuota(pod)
 					}
 					return false
 				default:
@@ -188,10 +222,23 @@ func New(ctx context.Context, obj runtime.Object, handle framework.Handle) (fram
 			},
 		},
 	)
+	pluginmetrics.Register()
+	pluginmetrics.SetElasticQuotaSource(c.elasticQuotaUsage)
 	logger.Info("CapacityScheduling start")
 	return c, nil
 }
 
+// elasticQuotaUsage returns the accounting of all the ElasticQuotas for the metrics.
+func (c *CapacityScheduling) elasticQuotaUsage() []pluginmetrics.ElasticQuotaUsage {
+	c.RLock()
+	defer c.RUnlock()
+	var result []pluginmetrics.ElasticQuotaUsage
+	for _, info := range c.elasticQuotaInfos {
+		result = append(result, info.usage()...)
+	}
+	return result
+}
+
 func (c *CapacityScheduling) EventsToRegister(_ context.Context) ([]framework.ClusterEventWithHint, error) {
 	// To register a custom event, follow the naming convention at:
 	// https://github.com/kubernetes/kubernetes/pull/101394
@@ -683,7 +730,7 @@ func (c *CapacityScheduling) addElasticQuota(obj interface{}) {
 		return
 	}
 
-	elasticQuotaInfo := newElasticQuotaInfo(eq.Namespace, eq.Spec.Min, eq.Spec.Max, nil)
+	elasticQuotaInfo := newElasticQuotaInfo(eq.Namespace, eq.Name, eq.Spec.Min, eq.Spec.Max, nil)
 
 	c.Lock()
 	defer c.Unlock()
@@ -693,7 +740,7 @@ func (c *CapacityScheduling) addElasticQuota(obj interface{}) {
 func (c *CapacityScheduling) updateElasticQuota(oldObj, newObj interface{}) {
 	oldEQ := oldObj.(*v1alpha1.ElasticQuota)
 	newEQ := newObj.(*v1alpha1.ElasticQuota)
-	newEQInfo := newElasticQuotaInfo(newEQ.Namespace, newEQ.Spec.Min, newEQ.Spec.Max, nil)
+	newEQInfo := newElasticQuotaInfo(newEQ.Namespace, newEQ.Name, newEQ.Spec.Min, newEQ.Spec.Max, nil)
 
 	c.Lock()
 	defer c.Unlock()
@@ -740,7 +787,7 @@ func (c *CapacityScheduling) addPod(obj interface{}) {
 		if len(eqs) > 0 {
 			// only one elasticquota is supported in each namespace
 			eq := eqs[0]
-			elasticQuotaInfo = newElasticQuotaInfo(eq.Namespace, eq.Spec.Min, eq.Spec.Max, nil)
+			elasticQuotaInfo = newElasticQuotaInfo(eq.Namespace, eq.Name, eq.Spec.Min, eq.Spec.Max, nil)
 			c.elasticQuotaInfos[eq.Namespace] = elasticQuotaInfo
 		}
 	}
@@ -757,11 +804,11 @@ func (c *CapacityScheduling) updatePod(oldObj, newObj interface{}) {
 	oldPod := oldObj.(*v1.Pod)
 	newPod := newObj.(*v1.Pod)
 
-	if oldPod.Status.Phase == v1.PodSucceeded || oldPod.Status.Phase == v1.PodFailed {
+	if util.IsPodTerminated(oldPod) {
 		return
 	}
 
-	if newPod.Status.Phase != v1.PodRunning && newPod.Status.Phase != v1.PodPending {
+	if util.IsPodTerminated(newPod) {
 		c.Lock()
 		defer c.Unlock()
 
@@ -834,51 +881,11 @@ func getPDBLister(informerFactory informers.SharedInformerFactory) policylisters
 	return informerFactory.Policy().V1().PodDisruptionBudgets().Lister()
 }
 
-// computePodResourceRequest returns a framework.Resource that covers the largest
-// width in each resource dimension. Because init-containers run sequentially, we collect
-// the max in each dimension iteratively. In contrast, we sum the resource vectors for
-// regular containers since they run simultaneously.
-//
-// If Pod Overhead is specified and the feature gate is set, the resources defined for Overhead
-// are added to the calculated Resource request sum
-//
-// Example:
-//
-// Pod:
-//
-//	InitContainers
-//	  IC1:
-//	    CPU: 2
-//	    Memory: 1G
-//	  IC2:
-//	    CPU: 2
-//	    Memory: 3G
-//	Containers
-//	  C1:
-//	    CPU: 2
-//	    Memory: 1G
-//	  C2:
-//	    CPU: 1
-//	    Memory: 1G
-//
-// Result: CPU: 3, Memory: 3G
+// computePodResourceRequest returns the resources of its quota used by the pod, accounted as
+// the ElasticQuota controller does: the effective request of its containers and init containers,
+// plus its overhead.
 func computePodResourceRequest(pod *v1.Pod) *framework.Resource {
-	result := &framework.Resource{}
-	for _, container := range pod.Spec.Containers {
-		result.Add(container.Resources.Requests)
-	}
-
-	// take max_resource(sum_pod, any_init_container)
-	for _, container := range pod.Spec.InitContainers {
-		result.SetMaxResource(container.Resources.Requests)
-	}
-
-	// If Overhead is being utilized, add to the total requests for the pod
-	if pod.Spec.Overhead != nil {
-		result.Add(pod.Spec.Overhead)
-	}
-
-	return result
+	return framework.NewResource(util.PodQuotaRequest(pod))
 }
 
 // filterPodsWithPDBViolation groups the given "pods" into two groups of "violatingPods"
@@ -932,8 +939,3 @@ func filterPodsWithPDBViolation(podInfos []*framework.PodInfo, pdbs []*policy.Po
 	}
 	return violatingPods, nonViolatingPods
 }
-
-// assignedPod selects pods that are assigned (scheduled and running).
-func assignedPod(pod *v1.Pod) bool {
-	return len(pod.Spec.NodeName) != 0
-}
@@ -1030,10 +1030,12 @@ func TestAddElasticQuota(t *testing.T) {
 			expected: map[string]*ElasticQuotaInfo{
 				"ns1": {
 					Namespace: "ns1",
+					Name:      "t1-eq1",
 					pods:      sets.Set[string]{},
 					Max: &framework.Resource{
-						MilliCPU: 100,
-						Memory:   1000,
+						MilliCPU:         100,
+						Memory:           1000,
+						EphemeralStorage: UpperBoundOfMax,
 					},
 					Min: &framework.Resource{
 						MilliCPU: 10,
@@ -1055,6 +1057,7 @@ func TestAddElasticQuota(t *testing.T) {
 			expected: map[string]*ElasticQuotaInfo{
 				"ns1": {
 					Namespace: "ns1",
+					Name:      "t1-eq1",
 					pods:      sets.Set[string]{},
 					Max: &framework.Resource{
 						MilliCPU:         UpperBoundOfMax,
@@ -1081,10 +1084,12 @@ func TestAddElasticQuota(t *testing.T) {
 			expected: map[string]*ElasticQuotaInfo{
 				"ns1": {
 					Namespace: "ns1",
+					Name:      "t1-eq1",
 					pods:      sets.Set[string]{},
 					Max: &framework.Resource{
-						MilliCPU: 100,
-						Memory:   1000,
+						MilliCPU:         100,
+						Memory:           1000,
+						EphemeralStorage: UpperBoundOfMax,
 					},
 					Min: &framework.Resource{
 						MilliCPU:         LowerBoundOfMin,
@@ -1107,6 +1112,7 @@ func TestAddElasticQuota(t *testing.T) {
 			expected: map[string]*ElasticQuotaInfo{
 				"ns1": {
 					Namespace: "ns1",
+					Name:      "t1-eq1",
 					pods:      sets.Set[string]{},
 					Max: &framework.Resource{
 						MilliCPU:         UpperBoundOfMax,
@@ -1182,10 +1188,12 @@ func TestUpdateElasticQuota(t *testing.T) {
 			expected: map[string]*ElasticQuotaInfo{
 				"ns1": {
 					Namespace: "ns1",
+					Name:      "t1-eq1",
 					pods:      sets.Set[string]{},
 					Max: &framework.Resource{
-						MilliCPU: 300,
-						Memory:   1000,
+						MilliCPU:         300,
+						Memory:           1000,
+						EphemeralStorage: UpperBoundOfMax,
 					},
 					Min: &framework.Resource{
 						MilliCPU: 10,
@@ -1309,10 +1317,12 @@ func TestAddPod(t *testing.T) {
 			expected: map[string]*ElasticQuotaInfo{
 				"ns1": {
 					Namespace: "ns1",
+					Name:      "t1-eq1",
 					pods:      sets.New("t1-p1", "t1-p2", "t1-p3"),
 					Max: &framework.Resource{
-						MilliCPU: 100,
-						Memory:   1000,
+						MilliCPU:         100,
+						Memory:           1000,
+						EphemeralStorage: UpperBoundOfMax,
 					},
 					Min: &framework.Resource{
 						MilliCPU: 10,
@@ -1389,10 +1399,12 @@ func TestUpdatePod(t *testing.T) {
 			expected: map[string]*ElasticQuotaInfo{
 				"ns1": {
 					Namespace: "ns1",
+					Name:      "t1-eq1",
 					pods:      sets.New("t1-p1"),
 					Max: &framework.Resource{
-						MilliCPU: 100,
-						Memory:   1000,
+						MilliCPU:         100,
+						Memory:           1000,
+						EphemeralStorage: UpperBoundOfMax,
 					},
 					Min: &framework.Resource{
 						MilliCPU: 10,
@@ -1421,10 +1433,12 @@ func TestUpdatePod(t *testing.T) {
 			expected: map[string]*ElasticQuotaInfo{
 				"ns1": {
 					Namespace: "ns1",
+					Name:      "t1-eq1",
 					pods:      sets.Set[string]{},
 					Max: &framework.Resource{
-						MilliCPU: 100,
-						Memory:   1000,
+						MilliCPU:         100,
+						Memory:           1000,
+						EphemeralStorage: UpperBoundOfMax,
 					},
 					Min: &framework.Resource{
 						MilliCPU: 10,
@@ -1505,10 +1519,12 @@ func TestDeletePod(t *testing.T) {
 			expected: map[string]*ElasticQuotaInfo{
 				"ns1": {
 					Namespace: "ns1",
+					Name:      "t1-eq1",
 					pods:      sets.New[string](),
 					Max: &framework.Resource{
-						MilliCPU: 100,
-						Memory:   1000,
+						MilliCPU:         100,
+						Memory:           1000,
+						EphemeralStorage: UpperBoundOfMax,
 					},
 					Min: &framework.Resource{
 						MilliCPU: 10,
@@ -1538,10 +1554,12 @@ func TestDeletePod(t *testing.T) {
 			expected: map[string]*ElasticQuotaInfo{
 				"ns1": {
 					Namespace: "ns1",
+					Name:      "t1-eq1",
 					pods:      sets.New("t1-p2"),
 					Max: &framework.Resource{
-						MilliCPU: 100,
-						Memory:   1000,
+						MilliCPU:         100,
+						Memory:           1000,
+						EphemeralStorage: UpperBoundOfMax,
 					},
 					Min: &framework.Resource{
 						MilliCPU: 10,
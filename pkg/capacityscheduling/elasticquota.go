@@ -23,9 +23,17 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/utils/ptr"
+
+	pluginmetrics "sigs.k8s.io/scheduler-plugins/pkg/metrics"
 	"sigs.k8s.io/scheduler-plugins/pkg/util"
 )
 
+// The bounds of the resources missing from the Min and the Max of an ElasticQuota:
+// a resource missing from Max is unbounded, while a resource missing from Min has no guarantee,
+// so that any usage of it is over Min. It applies alike to the standard resources (cpu, memory,
+// ephemeral-storage) and to the scalar ones (extended resources, e.g. nvidia.com/gpu or its
+// partitions, hugepages and attachable volumes). The number of pods is not enforced.
 const (
 	UpperBoundOfMax = math.MaxInt64
 	LowerBoundOfMin = 0
@@ -62,25 +70,20 @@ func (e ElasticQuotaInfos) aggregatedUsedOverMinWith(podRequest framework.Resour
 // Each namespace can only have one ElasticQuota.
 type ElasticQuotaInfo struct {
 	Namespace string
+	Name      string
 	pods      sets.Set[string]
 	Min       *framework.Resource
 	Max       *framework.Resource
 	Used      *framework.Resource
 }
 
-func newElasticQuotaInfo(namespace string, min, max, used v1.ResourceList) *ElasticQuotaInfo {
-	if min == nil {
-		min = makeResourceListForBound(LowerBoundOfMin)
-	}
-	if max == nil {
-		max = makeResourceListForBound(UpperBoundOfMax)
-	}
-
+func newElasticQuotaInfo(namespace, name string, min, max, used v1.ResourceList) *ElasticQuotaInfo {
 	elasticQuotaInfo := &ElasticQuotaInfo{
 		Namespace: namespace,
+		Name:      name,
 		pods:      sets.New[string](),
-		Min:       framework.NewResource(min),
-		Max:       framework.NewResource(max),
+		Min:       framework.NewResource(makeResourceListForBound(min, LowerBoundOfMin)),
+		Max:       framework.NewResource(makeResourceListForBound(max, UpperBoundOfMax)),
 		Used:      framework.NewResource(used),
 	}
 	return elasticQuotaInfo
@@ -133,6 +136,7 @@ func (e *ElasticQuotaInfo) usedOverMin() bool {
 func (e *ElasticQuotaInfo) clone() *ElasticQuotaInfo {
 	newEQInfo := &ElasticQuotaInfo{
 		Namespace: e.Namespace,
+		Name:      e.Name,
 		pods:      sets.New[string](),
 	}
 
@@ -190,6 +194,8 @@ func cmp(x, y *framework.Resource, bound int64) bool {
 	return cmp2(x, &framework.Resource{}, y, bound)
 }
 
+// cmp2 returns true if x1 + x2 is greater than y for any resource. The standard resources are always
+// set in y, while the scalar resources missing from y are compared with bound.
 func cmp2(x1, x2, y *framework.Resource, bound int64) bool {
 	if x1.MilliCPU+x2.MilliCPU > y.MilliCPU {
 		return true
@@ -203,16 +209,12 @@ func cmp2(x1, x2, y *framework.Resource, bound int64) bool {
 		return true
 	}
 
-	if x1.AllowedPodNumber+x2.AllowedPodNumber > y.AllowedPodNumber {
-		return true
-	}
-
-	for rName, rQuant := range x1.ScalarResources {
+	for _, rName := range scalarResourceNames(x1, x2) {
 		yQuant := bound
 		if yq, ok := y.ScalarResources[rName]; ok {
 			yQuant = yq
 		}
-		if rQuant+x2.ScalarResources[rName] > yQuant {
+		if x1.ScalarResources[rName]+x2.ScalarResources[rName] > yQuant {
 			return true
 		}
 	}
@@ -220,10 +222,63 @@ func cmp2(x1, x2, y *framework.Resource, bound int64) bool {
 	return false
 }
 
-func makeResourceListForBound(bound int64) v1.ResourceList {
-	return v1.ResourceList{
-		v1.ResourceCPU:              *resource.NewMilliQuantity(bound, resource.DecimalSI),
-		v1.ResourceMemory:           *resource.NewQuantity(bound, resource.BinarySI),
-		v1.ResourceEphemeralStorage: *resource.NewQuantity(bound, resource.BinarySI),
+// scalarResourceNames returns the names of the scalar resources set in any of the given resources.
+func scalarResourceNames(resources ...*framework.Resource) []v1.ResourceName {
+	names := sets.New[v1.ResourceName]()
+	for _, r := range resources {
+		for rName := range r.ScalarResources {
+			names.Insert(rName)
+		}
+	}
+	return sets.List(names)
+}
+
+// makeResourceListForBound returns a copy of the given resources, where the standard resources
+// which are missing are set to bound. The scalar resources are left as is, the missing ones
+// being compared with the bound by cmp2.
+func makeResourceListForBound(resources v1.ResourceList, bound int64) v1.ResourceList {
+	result := resources.DeepCopy()
+	if result == nil {
+		result = make(v1.ResourceList)
+	}
+	if _, ok := result[v1.ResourceCPU]; !ok {
+		result[v1.ResourceCPU] = *resource.NewMilliQuantity(bound, resource.DecimalSI)
+	}
+	if _, ok := result[v1.ResourceMemory]; !ok {
+		result[v1.ResourceMemory] = *resource.NewQuantity(bound, resource.BinarySI)
+	}
+	if _, ok := result[v1.ResourceEphemeralStorage]; !ok {
+		result[v1.ResourceEphemeralStorage] = *resource.NewQuantity(bound, resource.BinarySI)
+	}
+	return result
+}
+
+// usage returns the accounting of the quota resources reported in the metrics.
+// The pods resource is not bounded by quotas, so it is left out.
+func (e *ElasticQuotaInfo) usage() []pluginmetrics.ElasticQuotaUsage {
+	used, min, max := util.ResourceList(e.Used), util.ResourceList(e.Min), util.ResourceList(e.Max)
+	names := sets.New[v1.ResourceName]()
+	for _, list := range []v1.ResourceList{used, min, max} {
+		for name := range list {
+			names.Insert(name)
+		}
+	}
+	names.Delete(v1.ResourcePods)
+
+	var result []pluginmetrics.ElasticQuotaUsage
+	for _, name := range sets.List(names) {
+		u := pluginmetrics.ElasticQuotaUsage{Namespace: e.Namespace, Resource: string(name)}
+		if q, ok := used[name]; ok {
+			u.Used = q.AsApproximateFloat64()
+		}
+		if q, ok := min[name]; ok {
+			u.Min = ptr.To(q.AsApproximateFloat64())
+		}
+		// A max at the upper bound is unbounded.
+		if q, ok := max[name]; ok && q.Value() != UpperBoundOfMax && q.MilliValue() != UpperBoundOfMax {
+			u.Max = ptr.To(q.AsApproximateFloat64())
+		}
+		result = append(result, u)
 	}
+	return result
 }
@@ -20,10 +20,14 @@ import (
 	"reflect"
 	"testing"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/utils/ptr"
+
+	pluginmetrics "sigs.k8s.io/scheduler-plugins/pkg/metrics"
 )
 
 func TestReserveResource(t *testing.T) {
@@ -526,10 +530,12 @@ func TestNewElasticQuotaInfo(t *testing.T) {
 			},
 			expected: &ElasticQuotaInfo{
 				Namespace: "ns1",
+				Name:      "eq",
 				pods:      sets.Set[string]{},
 				Max: &framework.Resource{
-					MilliCPU: 100,
-					Memory:   1000,
+					MilliCPU:         100,
+					Memory:           1000,
+					EphemeralStorage: UpperBoundOfMax,
 				},
 				Min: &framework.Resource{
 					MilliCPU: 10,
@@ -551,6 +557,7 @@ func TestNewElasticQuotaInfo(t *testing.T) {
 			},
 			expected: &ElasticQuotaInfo{
 				Namespace: "ns1",
+				Name:      "eq",
 				pods:      sets.Set[string]{},
 				Max: &framework.Resource{
 					MilliCPU:         UpperBoundOfMax,
@@ -577,10 +584,12 @@ func TestNewElasticQuotaInfo(t *testing.T) {
 			},
 			expected: &ElasticQuotaInfo{
 				Namespace: "ns1",
+				Name:      "eq",
 				pods:      sets.Set[string]{},
 				Max: &framework.Resource{
-					MilliCPU: 100,
-					Memory:   1000,
+					MilliCPU:         100,
+					Memory:           1000,
+					EphemeralStorage: UpperBoundOfMax,
 				},
 				Min: &framework.Resource{
 					MilliCPU:         LowerBoundOfMin,
@@ -603,6 +612,7 @@ func TestNewElasticQuotaInfo(t *testing.T) {
 			},
 			expected: &ElasticQuotaInfo{
 				Namespace: "ns1",
+				Name:      "eq",
 				pods:      sets.Set[string]{},
 				Max: &framework.Resource{
 					MilliCPU:         UpperBoundOfMax,
@@ -624,9 +634,146 @@ func TestNewElasticQuotaInfo(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			eqp := tt.elasticQuotaParam
-			if got := newElasticQuotaInfo(eqp.namespace, eqp.min, eqp.max, eqp.used); !reflect.DeepEqual(got, tt.expected) {
+			if got := newElasticQuotaInfo(eqp.namespace, "eq", eqp.min, eqp.max, eqp.used); !reflect.DeepEqual(got, tt.expected) {
 				t.Errorf("expected %v, got %v", tt.expected, got)
 			}
 		})
 	}
 }
+
+func TestElasticQuotaBounds(t *testing.T) {
+	const (
+		migResource       v1.ResourceName = "nvidia.com/mig-1g.5gb"
+		hugePagesResource v1.ResourceName = "hugepages-2Mi"
+	)
+	cpuMemory := v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("10"),
+		v1.ResourceMemory: resource.MustParse("10Gi"),
+	}
+
+	tests := []struct {
+		name        string
+		min         v1.ResourceList
+		max         v1.ResourceList
+		used        v1.ResourceList
+		podRequest  v1.ResourceList
+		overMinWith bool
+		overMaxWith bool
+	}{
+		{
+			name:        "resources missing from Max are unbounded, from Min are over it",
+			min:         v1.ResourceList{ResourceGPU: resource.MustParse("1")},
+			max:         v1.ResourceList{ResourceGPU: resource.MustParse("2")},
+			podRequest:  cpuMemory,
+			overMinWith: true,
+			overMaxWith: false,
+		},
+		{
+			name:        "empty Max is unbounded, empty Min has no guarantee",
+			min:         v1.ResourceList{},
+			max:         v1.ResourceList{},
+			podRequest:  v1.ResourceList{v1.ResourceEphemeralStorage: resource.MustParse("1Gi"), ResourceGPU: resource.MustParse("1")},
+			overMinWith: true,
+			overMaxWith: false,
+		},
+		{
+			name:        "extended resource within Min",
+			min:         v1.ResourceList{ResourceGPU: resource.MustParse("2")},
+			max:         v1.ResourceList{ResourceGPU: resource.MustParse("4")},
+			used:        v1.ResourceList{ResourceGPU: resource.MustParse("1")},
+			podRequest:  v1.ResourceList{ResourceGPU: resource.MustParse("1")},
+			overMinWith: false,
+			overMaxWith: false,
+		},
+		{
+			name:        "extended resource over Max",
+			min:         v1.ResourceList{ResourceGPU: resource.MustParse("1")},
+			max:         v1.ResourceList{ResourceGPU: resource.MustParse("2")},
+			used:        v1.ResourceList{ResourceGPU: resource.MustParse("1")},
+			podRequest:  v1.ResourceList{ResourceGPU: resource.MustParse("2")},
+			overMinWith: true,
+			overMaxWith: true,
+		},
+		{
+			name:        "GPU partition over Max while the full GPUs are not",
+			max:         v1.ResourceList{ResourceGPU: resource.MustParse("8"), migResource: resource.MustParse("1")},
+			podRequest:  v1.ResourceList{migResource: resource.MustParse("2")},
+			overMinWith: true,
+			overMaxWith: true,
+		},
+		{
+			name:        "hugepages within Min and Max",
+			min:         v1.ResourceList{hugePagesResource: resource.MustParse("1Gi")},
+			max:         v1.ResourceList{hugePagesResource: resource.MustParse("2Gi")},
+			podRequest:  v1.ResourceList{hugePagesResource: resource.MustParse("512Mi")},
+			overMinWith: false,
+			overMaxWith: false,
+		},
+		{
+			name:        "hugepages over Max",
+			max:         v1.ResourceList{hugePagesResource: resource.MustParse("1Gi")},
+			podRequest:  v1.ResourceList{hugePagesResource: resource.MustParse("2Gi")},
+			overMinWith: true,
+			overMaxWith: true,
+		},
+		{
+			name:        "ephemeral storage missing from Max is unbounded",
+			max:         cpuMemory,
+			podRequest:  v1.ResourceList{v1.ResourceEphemeralStorage: resource.MustParse("100Gi")},
+			overMinWith: true,
+			overMaxWith: false,
+		},
+		{
+			name:        "ephemeral storage over Max",
+			max:         v1.ResourceList{v1.ResourceEphemeralStorage: resource.MustParse("10Gi")},
+			used:        v1.ResourceList{v1.ResourceEphemeralStorage: resource.MustParse("5Gi")},
+			podRequest:  v1.ResourceList{v1.ResourceEphemeralStorage: resource.MustParse("6Gi")},
+			overMinWith: true,
+			overMaxWith: true,
+		},
+		{
+			name:        "used over Max on a resource the pod does not request",
+			min:         cpuMemory,
+			max:         v1.ResourceList{ResourceGPU: resource.MustParse("2")},
+			used:        v1.ResourceList{ResourceGPU: resource.MustParse("3")},
+			podRequest:  v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")},
+			overMinWith: true,
+			overMaxWith: true,
+		},
+		{
+			name:        "nil Min and Max",
+			podRequest:  cpuMemory,
+			overMinWith: true,
+			overMaxWith: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			elasticQuotaInfo := newElasticQuotaInfo("ns1", "eq", tt.min, tt.max, tt.used)
+			podRequest := framework.NewResource(tt.podRequest)
+			if got := elasticQuotaInfo.usedOverMinWith(podRequest); got != tt.overMinWith {
+				t.Errorf("usedOverMinWith: expected %v, got %v", tt.overMinWith, got)
+			}
+			if got := elasticQuotaInfo.usedOverMaxWith(podRequest); got != tt.overMaxWith {
+				t.Errorf("usedOverMaxWith: expected %v, got %v", tt.overMaxWith, got)
+			}
+		})
+	}
+}
+
+func TestElasticQuotaUsage(t *testing.T) {
+	info := newElasticQuotaInfo("ns1", "eq",
+		v1.ResourceList{v1.ResourceCPU: resource.MustParse("2"), ResourceGPU: resource.MustParse("1")},
+		v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")},
+		v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m"), v1.ResourceMemory: resource.MustParse("1Ki")},
+	)
+	want := []pluginmetrics.ElasticQuotaUsage{
+		{Namespace: "ns1", Resource: "cpu", Used: 0.5, Min: ptr.To(2.0), Max: ptr.To(4.0)},
+		{Namespace: "ns1", Resource: "ephemeral-storage", Min: ptr.To(0.0)},
+		{Namespace: "ns1", Resource: "memory", Used: 1024, Min: ptr.To(0.0)},
+		{Namespace: "ns1", Resource: string(ResourceGPU), Min: ptr.To(1.0)},
+	}
+	if got := info.usage(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected usage %v, got %v", want, got)
+	}
+}
@@ -19,21 +19,32 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"math/big"
+	"sort"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	quota "k8s.io/apiserver/pkg/quota/v1"
 	"k8s.io/client-go/tools/record"
 
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	schedv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/util"
 )
 
 type ElasticQuotaReconciler struct {
@@ -67,20 +78,30 @@ func (r *ElasticQuotaReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	}
 
 	eq := &eqList.Items[0]
-	used, err := r.computeElasticQuotaUsed(ctx, req.Namespace, eq)
+	used, pending, err := r.computeElasticQuotaUsage(ctx, req.Namespace, eq)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	// Ignore this loop if the usage value has not changed
-	if apiequality.Semantic.DeepEqual(used, eq.Status.Used) {
-		return ctrl.Result{}, nil
+	// What a quota lends depends on what all the quotas borrow
+	allEQs := &schedv1alpha1.ElasticQuotaList{}
+	if err := r.List(ctx, allEQs); err != nil {
+		return ctrl.Result{}, err
 	}
 
-	// create a usage object that is based on the elastic quota version that will handle updates
-	// by default, we set used to the current status
+	// create a status object that is based on the elastic quota version that will handle updates
 	newEQ := eq.DeepCopy()
 	newEQ.Status.Used = used
+	newEQ.Status.Borrowed = computeBorrowed(eq.Spec.Min, used)
+	newEQ.Status.Lent = computeElasticQuotaLent(eq, used, allEQs.Items)
+	newEQ.Status.PendingRequests = pending
+	setElasticQuotaConditions(newEQ)
+
+	// Ignore this loop if the status has not changed
+	if apiequality.Semantic.DeepEqual(newEQ.Status, eq.Status) {
+		return ctrl.Result{}, nil
+	}
+
 	if err = r.patchElasticQuota(ctx, eq, newEQ); err != nil {
 		return ctrl.Result{}, err
 	}
@@ -93,65 +114,139 @@ func (r *ElasticQuotaReconciler) patchElasticQuota(ctx context.Context, old, new
 	return r.Status().Patch(ctx, new, patch)
 }
 
-func (r *ElasticQuotaReconciler) computeElasticQuotaUsed(ctx context.Context, namespace string, eq *schedv1alpha1.ElasticQuota) (v1.ResourceList, error) {
-	used := newZeroUsed(eq)
+// computeElasticQuotaUsage returns the resources used by the pods of the namespace, and the resources
+// requested by its pods waiting to be scheduled, accounted as CapacityScheduling does.
+func (r *ElasticQuotaReconciler) computeElasticQuotaUsage(ctx context.Context, namespace string, eq *schedv1alpha1.ElasticQuota) (used, pending v1.ResourceList, err error) {
 	podList := &v1.PodList{}
 	if err := r.List(ctx, podList, client.InNamespace(namespace)); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	for _, p := range podList.Items {
-		if p.Status.Phase == v1.PodRunning {
-			used = quota.Add(used, computePodResourceRequest(&p))
+	used, pending = util.ComputeQuotaUsage(podList.Items)
+	return quota.Add(newZeroUsed(eq), used), pending, nil
+}
+
+// computeBorrowed returns the usage beyond min of each used resource. As in CapacityScheduling,
+// a resource missing from min has no guarantee, so that all of its usage is borrowed, and the
+// number of pods is left out.
+func computeBorrowed(min, used v1.ResourceList) v1.ResourceList {
+	borrowed := v1.ResourceList{}
+	for name, u := range used {
+		if name == v1.ResourcePods {
+			continue
+		}
+		b := u.DeepCopy()
+		if m, ok := min[name]; ok {
+			b.Sub(m)
 		}
+		if b.Sign() < 0 {
+			b = *resource.NewQuantity(0, u.Format)
+		}
+		borrowed[name] = b
 	}
-	return used, nil
+	return borrowed
 }
 
-// computePodResourceRequest returns a v1.ResourceList that covers the largest
-// width in each resource dimension. Because init-containers run sequentially, we collect
-// the max in each dimension iteratively. In contrast, we sum the resource vectors for
-// regular containers since they run simultaneously.
-//
-// If Pod Overhead is specified and the feature gate is set, the resources defined for Overhead
-// are added to the calculated Resource request sum
-//
-// Example:
-//
-// Pod:
-//
-//	InitContainers
-//	  IC1:
-//	    CPU: 2
-//	    Memory: 1G
-//	  IC2:
-//	    CPU: 2
-//	    Memory: 3G
-//	Containers
-//	  C1:
-//	    CPU: 2
-//	    Memory: 1G
-//	  C2:
-//	    CPU: 1
-//	    Memory: 1G
-//
-// Result: CPU: 3, Memory: 3G
-func computePodResourceRequest(pod *v1.Pod) v1.ResourceList {
-	result := v1.ResourceList{}
-	for _, container := range pod.Spec.Containers {
-		result = quota.Add(result, container.Resources.Requests)
-	}
-	initRes := v1.ResourceList{}
-	// take max_resource for init_containers
-	for _, container := range pod.Spec.InitContainers {
-		initRes = quota.Max(initRes, container.Resources.Requests)
-	}
-	// If Overhead is being utilized, add to the total requests for the pod
-	if pod.Spec.Overhead != nil {
-		quota.Add(result, pod.Spec.Overhead)
-	}
-	// take max_resource for init_containers and containers
-	return quota.Max(result, initRes)
+// computeUnusedMin returns the part of min of each resource which is not used.
+func computeUnusedMin(min, used v1.ResourceList) v1.ResourceList {
+	unused := v1.ResourceList{}
+	for name, m := range min {
+		if name == v1.ResourcePods {
+			continue
+		}
+		u := m.DeepCopy()
+		if q, ok := used[name]; ok {
+			u.Sub(q)
+		}
+		if u.Sign() < 0 {
+			u = *resource.NewQuantity(0, m.Format)
+		}
+		unused[name] = u
+	}
+	return unused
+}
+
+// computeElasticQuotaLent returns the part of the unused Min of eq which other quotas borrow. What
+// all the quotas borrow is lent by the quotas using less than their Min, in proportion to their
+// unused Min. eq is accounted with used, the other quotas with their last reported usage.
+func computeElasticQuotaLent(eq *schedv1alpha1.ElasticQuota, used v1.ResourceList, eqs []schedv1alpha1.ElasticQuota) v1.ResourceList {
+	unused := computeUnusedMin(eq.Spec.Min, used)
+	totalBorrowed := computeBorrowed(eq.Spec.Min, used)
+	totalUnused := unused.DeepCopy()
+	for i := range eqs {
+		other := &eqs[i]
+		if other.Namespace == eq.Namespace && other.Name == eq.Name {
+			continue
+		}
+		totalBorrowed = quota.Add(totalBorrowed, computeBorrowed(other.Spec.Min, other.Status.Used))
+		totalUnused = quota.Add(totalUnused, computeUnusedMin(other.Spec.Min, other.Status.Used))
+	}
+
+	lent := v1.ResourceList{}
+	for name, u := range unused {
+		borrowed, total := totalBorrowed[name], totalUnused[name]
+		if borrowed.Cmp(total) >= 0 {
+			// All the unused Min is borrowed
+			lent[name] = u
+			continue
+		}
+		// u * borrowed / total, on big integers as the product of milli-values may overflow
+		l := new(big.Int).Mul(big.NewInt(u.MilliValue()), big.NewInt(borrowed.MilliValue()))
+		l.Quo(l, big.NewInt(total.MilliValue()))
+		lent[name] = *resource.NewMilliQuantity(l.Int64(), u.Format)
+	}
+	return lent
+}
+
+// setElasticQuotaConditions sets the OverMin and AtMax conditions of eq from its Borrowed and Used status.
+func setElasticQuotaConditions(eq *schedv1alpha1.ElasticQuota) {
+	var overMin []string
+	for name, b := range eq.Status.Borrowed {
+		if b.Sign() > 0 {
+			overMin = append(overMin, string(name))
+		}
+	}
+	overMinCondition := metav1.Condition{
+		Type:               schedv1alpha1.ElasticQuotaOverMin,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: eq.Generation,
+		Reason:             "UsageWithinMin",
+		Message:            "All the resources are used within Min",
+	}
+	if len(overMin) > 0 {
+		overMinCondition.Status = metav1.ConditionTrue
+		overMinCondition.Reason = "UsageOverMin"
+		overMinCondition.Message = fmt.Sprintf("Resources used over Min: %s", joinSorted(overMin))
+	}
+	meta.SetStatusCondition(&eq.Status.Conditions, overMinCondition)
+
+	var atMax []string
+	for name, m := range eq.Spec.Max {
+		if name == v1.ResourcePods {
+			continue
+		}
+		if u, ok := eq.Status.Used[name]; ok && u.Cmp(m) >= 0 {
+			atMax = append(atMax, string(name))
+		}
+	}
+	atMaxCondition := metav1.Condition{
+		Type:               schedv1alpha1.ElasticQuotaAtMax,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: eq.Generation,
+		Reason:             "UsageBelowMax",
+		Message:            "All the resources are used below Max",
+	}
+	if len(atMax) > 0 {
+		atMaxCondition.Status = metav1.ConditionTrue
+		atMaxCondition.Reason = "UsageAtMax"
+		atMaxCondition.Message = fmt.Sprintf("Resources used up to Max: %s", joinSorted(atMax))
+	}
+	meta.SetStatusCondition(&eq.Status.Conditions, atMaxCondition)
+}
+
+func joinSorted(names []string) string {
+	sort.Strings(names)
+	return strings.Join(names, ", ")
 }
 
 // newZeroUsed will return the zero value of the union of min and max
@@ -168,10 +263,45 @@ func newZeroUsed(eq *schedv1alpha1.ElasticQuota) v1.ResourceList {
 	return res
 }
 
+// otherElasticQuotas returns the requests to reconcile all the quotas but the given one, as what
+// they lend depends on its usage.
+func (r *ElasticQuotaReconciler) otherElasticQuotas(ctx context.Context, obj client.Object) []reconcile.Request {
+	eqList := &schedv1alpha1.ElasticQuotaList{}
+	if err := r.List(ctx, eqList); err != nil {
+		log.FromContext(ctx).Error(err, "Unable to list elasticquotas")
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, eq := range eqList.Items {
+		if eq.Namespace == obj.GetNamespace() && eq.Name == obj.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: eq.Namespace, Name: eq.Name}})
+	}
+	return requests
+}
+
+// elasticQuotaLendingChanged filters the quota updates which may change what the other quotas lend.
+var elasticQuotaLendingChanged = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldEQ, ok := e.ObjectOld.(*schedv1alpha1.ElasticQuota)
+		if !ok {
+			return false
+		}
+		newEQ, ok := e.ObjectNew.(*schedv1alpha1.ElasticQuota)
+		if !ok {
+			return false
+		}
+		return !quota.Equals(oldEQ.Spec.Min, newEQ.Spec.Min) || !quota.Equals(oldEQ.Status.Used, newEQ.Status.Used)
+	},
+}
+
 func (r *ElasticQuotaReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.recorder = mgr.GetEventRecorderFor("ElasticQuotaController")
 	return ctrl.NewControllerManagedBy(mgr).
 		Watches(&v1.Pod{}, &handler.EnqueueRequestForObject{}).
+		Watches(&schedv1alpha1.ElasticQuota{}, handler.EnqueueRequestsFromMapFunc(r.otherElasticQuotas),
+			builder.WithPredicates(elasticQuotaLendingChanged)).
 		For(&schedv1alpha1.ElasticQuota{}).
 		WithOptions(controller.Options{MaxConcurrentReconciles: r.Workers}).
 		Complete(r)
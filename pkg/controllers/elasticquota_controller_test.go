@@ -25,6 +25,8 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -57,7 +59,7 @@ func TestElasticQuotaController_Run(t *testing.T) {
 					Max(testutil.MakeResourceList().CPU(5).Mem(15).GPU(1).Obj()).Obj(),
 			},
 			pods: []*v1.Pod{
-				testutil.MakePod("t1-ns1", "pod1").Phase(v1.PodRunning).Container(
+				testutil.MakePod("t1-ns1", "pod1").Phase(v1.PodRunning).Node("node-a").Container(
 					testutil.MakeResourceList().CPU(1).Mem(2).GPU(1).Obj()).Obj(),
 				testutil.MakePod("t1-ns1", "pod2").Phase(v1.PodPending).Container(
 					testutil.MakeResourceList().CPU(1).Mem(2).GPU(0).Obj()).Obj(),
@@ -77,13 +79,13 @@ func TestElasticQuotaController_Run(t *testing.T) {
 
 			pods: []*v1.Pod{
 				// CPU: 2, Mem: 4
-				testutil.MakePod("t2-ns1", "pod1").Phase(v1.PodRunning).
+				testutil.MakePod("t2-ns1", "pod1").Phase(v1.PodRunning).Node("node-a").
 					Container(
 						testutil.MakeResourceList().CPU(1).Mem(2).Obj()).
 					Container(
 						testutil.MakeResourceList().CPU(1).Mem(2).Obj()).Obj(),
 				// CPU: 3, Mem: 3
-				testutil.MakePod("t2-ns1", "pod2").Phase(v1.PodRunning).
+				testutil.MakePod("t2-ns1", "pod2").Phase(v1.PodRunning).Node("node-a").
 					InitContainerRequest(
 						testutil.MakeResourceList().CPU(2).Mem(1).Obj()).
 					InitContainerRequest(
@@ -110,17 +112,17 @@ func TestElasticQuotaController_Run(t *testing.T) {
 			},
 			pods: []*v1.Pod{
 				// CPU: 2, Mem: 4
-				testutil.MakePod("t3-ns1", "pod1").Phase(v1.PodRunning).
+				testutil.MakePod("t3-ns1", "pod1").Phase(v1.PodRunning).Node("node-a").
 					Container(testutil.MakeResourceList().CPU(1).Mem(2).GPU(1).Obj()).
 					Container(testutil.MakeResourceList().CPU(1).Mem(2).Obj()).Obj(),
 				// CPU: 3, Mem: 3
-				testutil.MakePod("t3-ns1", "pod1").Phase(v1.PodPending).
+				testutil.MakePod("t3-ns1", "pod1").Phase(v1.PodPending).Node("node-a").
 					InitContainerRequest(testutil.MakeResourceList().CPU(2).Mem(1).Obj()).
 					InitContainerRequest(testutil.MakeResourceList().CPU(2).Mem(3).Obj()).
 					Container(testutil.MakeResourceList().CPU(2).Mem(1).Obj()).
 					Container(testutil.MakeResourceList().CPU(1).Mem(1).Obj()).Obj(),
 				// CPU: 4, Mem: 3
-				testutil.MakePod("t3-ns2", "pod2").Phase(v1.PodRunning).
+				testutil.MakePod("t3-ns2", "pod2").Phase(v1.PodRunning).Node("node-a").
 					InitContainerRequest(testutil.MakeResourceList().CPU(2).Mem(1).Obj()).
 					InitContainerRequest(testutil.MakeResourceList().CPU(2).Mem(3).Obj()).
 					Container(testutil.MakeResourceList().CPU(3).Mem(1).Obj()).
@@ -170,7 +172,7 @@ func TestElasticQuotaController_Run(t *testing.T) {
 					Max(testutil.MakeResourceList().CPU(50).Mem(15).Obj()).Obj(),
 			},
 			pods: []*v1.Pod{
-				testutil.MakePod("t6-ns3", "pod1").Phase(v1.PodRunning).
+				testutil.MakePod("t6-ns3", "pod1").Phase(v1.PodRunning).Node("node-a").
 					Container(testutil.MakeResourceList().CPU(1).Mem(2).GPU(1).Obj()).
 					Container(testutil.MakeResourceList().CPU(1).Mem(2).Obj()).Obj(),
 			},
@@ -181,6 +183,29 @@ func TestElasticQuotaController_Run(t *testing.T) {
 					Used(testutil.MakeResourceList().CPU(0).Mem(0).GPU(0).Obj()).Obj(),
 			},
 		},
+		{
+			name: "bound pods count with their overhead until they terminate",
+			elasticQuotas: []*v1alpha1.ElasticQuota{
+				testutil.MakeEQ("t7-ns1", "t7-eq1").
+					Min(testutil.MakeResourceList().CPU(3).Mem(5).Obj()).
+					Max(testutil.MakeResourceList().CPU(5).Mem(15).Obj()).Obj(),
+			},
+			pods: []*v1.Pod{
+				// Bound, still initializing: CPU: 2, Mem: 2
+				testutil.MakePod("t7-ns1", "pod1").Phase(v1.PodPending).Node("node-a").
+					InitContainerRequest(testutil.MakeResourceList().CPU(1).Mem(2).Obj()).
+					Container(testutil.MakeResourceList().CPU(1).Mem(1).Obj()).
+					Overhead(testutil.MakeResourceList().CPU(1).Obj()).Obj(),
+				testutil.MakePod("t7-ns1", "pod2").Phase(v1.PodSucceeded).Node("node-a").
+					Container(testutil.MakeResourceList().CPU(2).Mem(2).Obj()).Obj(),
+				testutil.MakePod("t7-ns1", "pod3").Phase(v1.PodFailed).Node("node-a").
+					Container(testutil.MakeResourceList().CPU(2).Mem(2).Obj()).Obj(),
+			},
+			want: []*v1alpha1.ElasticQuota{
+				testutil.MakeEQ("t7-ns1", "t7-eq1").
+					Used(testutil.MakeResourceList().CPU(2).Mem(2).Obj()).Obj(),
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -266,3 +291,90 @@ func setUpEQ(ctx context.Context,
 
 	return controller, client
 }
+
+func TestElasticQuotaController_Status(t *testing.T) {
+	ctx := context.TODO()
+	eqs := []*v1alpha1.ElasticQuota{
+		// Uses 1 of its Min of 3 CPUs
+		testutil.MakeEQ("s-ns1", "eq1").
+			Min(testutil.MakeResourceList().CPU(3).Obj()).
+			Max(testutil.MakeResourceList().CPU(6).Obj()).Obj(),
+		// Borrows 2 CPUs and uses all of its Max
+		testutil.MakeEQ("s-ns2", "eq2").
+			Min(testutil.MakeResourceList().CPU(2).Obj()).
+			Max(testutil.MakeResourceList().CPU(4).Obj()).Obj(),
+		// Uses none of its Min of 1 CPU
+		testutil.MakeEQ("s-ns3", "eq3").
+			Min(testutil.MakeResourceList().CPU(1).Obj()).Obj(),
+	}
+	pods := []*v1.Pod{
+		testutil.MakePod("s-ns1", "running").Phase(v1.PodRunning).Node("n1").
+			Container(testutil.MakeResourceList().CPU(1).Obj()).Obj(),
+		testutil.MakePod("s-ns1", "pending").Phase(v1.PodPending).
+			Container(testutil.MakeResourceList().CPU(2).Mem(5).Obj()).Obj(),
+		testutil.MakePod("s-ns2", "running").Phase(v1.PodRunning).Node("n1").
+			Container(testutil.MakeResourceList().CPU(4).Obj()).Obj(),
+	}
+	controller, kClient := setUpEQ(ctx, t, eqs, pods)
+	controller.recorder = record.NewFakeRecorder(10)
+
+	// What a quota lends depends on the usage reported by the others, so it takes a second round to settle.
+	for i := 0; i < 2; i++ {
+		for _, eq := range eqs {
+			if _, err := controller.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{
+				Namespace: eq.Namespace,
+				Name:      eq.Name,
+			}}); err != nil {
+				t.Fatalf("reconcile: (%v)", err)
+			}
+		}
+	}
+
+	want := map[string]struct {
+		borrowed, lent, pending v1.ResourceList
+		overMin, atMax          metav1.ConditionStatus
+	}{
+		"s-ns1": {
+			borrowed: testutil.MakeResourceList().CPU(0).Obj(),
+			// The 3 unused CPUs lend the 2 borrowed ones, so 2/3 of its 2 unused CPUs are lent
+			lent:    v1.ResourceList{v1.ResourceCPU: resource.MustParse("1333m")},
+			pending: testutil.MakeResourceList().CPU(2).Mem(5).Obj(),
+			overMin: metav1.ConditionFalse,
+			atMax:   metav1.ConditionFalse,
+		},
+		"s-ns2": {
+			borrowed: testutil.MakeResourceList().CPU(2).Obj(),
+			lent:     testutil.MakeResourceList().CPU(0).Obj(),
+			overMin:  metav1.ConditionTrue,
+			atMax:    metav1.ConditionTrue,
+		},
+		"s-ns3": {
+			borrowed: testutil.MakeResourceList().CPU(0).Obj(),
+			lent:     v1.ResourceList{v1.ResourceCPU: resource.MustParse("666m")},
+			overMin:  metav1.ConditionFalse,
+			atMax:    metav1.ConditionFalse,
+		},
+	}
+	for _, eq := range eqs {
+		got := &v1alpha1.ElasticQuota{}
+		if err := kClient.Get(ctx, client.ObjectKeyFromObject(eq), got); err != nil {
+			t.Fatal(err)
+		}
+		w := want[eq.Namespace]
+		if !quota.Equals(got.Status.Borrowed, w.borrowed) {
+			t.Errorf("%s: want borrowed %v, got %v", eq.Namespace, w.borrowed, got.Status.Borrowed)
+		}
+		if !quota.Equals(got.Status.Lent, w.lent) {
+			t.Errorf("%s: want lent %v, got %v", eq.Namespace, w.lent, got.Status.Lent)
+		}
+		if !quota.Equals(got.Status.PendingRequests, w.pending) {
+			t.Errorf("%s: want pending requests %v, got %v", eq.Namespace, w.pending, got.Status.PendingRequests)
+		}
+		if c := meta.FindStatusCondition(got.Status.Conditions, v1alpha1.ElasticQuotaOverMin); c == nil || c.Status != w.overMin {
+			t.Errorf("%s: want OverMin %v, got %v", eq.Namespace, w.overMin, c)
+		}
+		if c := meta.FindStatusCondition(got.Status.Conditions, v1alpha1.ElasticQuotaAtMax); c == nil || c.Status != w.atMax {
+			t.Errorf("%s: want AtMax %v, got %v", eq.Namespace, w.atMax, c)
+		}
+	}
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	schedv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/util"
+)
+
+// resizeRetryPeriod is how long a rejected resize of a PodGroup waits before
+// being retried, e.g. until the gang is no longer partially bound.
+const resizeRetryPeriod = 30 * time.Second
+
+// workloadKind describes a workload kind PodGroups are automatically created for.
+type workloadKind struct {
+	gvk     schema.GroupVersionKind
+	newObj  func() client.Object
+	newList func() client.ObjectList
+	// minMember returns the number of pods the workload runs at once.
+	minMember func(obj client.Object) (int32, error)
+}
+
+var workloadKinds = []workloadKind{
+	{
+		gvk:       batchv1.SchemeGroupVersion.WithKind("Job"),
+		newObj:    func() client.Object { return &batchv1.Job{} },
+		newList:   func() client.ObjectList { return &batchv1.JobList{} },
+		minMember: jobMinMember,
+	},
+	{
+		gvk:       appsv1.SchemeGroupVersion.WithKind("StatefulSet"),
+		newObj:    func() client.Object { return &appsv1.StatefulSet{} },
+		newList:   func() client.ObjectList { return &appsv1.StatefulSetList{} },
+		minMember: statefulSetMinMember,
+	},
+	{
+		gvk:     appsv1.SchemeGroupVersion.WithKind("ReplicaSet"),
+		newObj:  func() client.Object { return &appsv1.ReplicaSet{} },
+		newList: func() client.ObjectList { return &appsv1.ReplicaSetList{} },
+		minMember: func(obj client.Object) (int32, error) {
+			return replicasOrOne(obj.(*appsv1.ReplicaSet).Spec.Replicas), nil
+		},
+	},
+	{
+		gvk: util.JobSetGVK,
+		newObj: func() client.Object {
+			u := &unstructured.Unstructured{}
+			u.SetGroupVersionKind(util.JobSetGVK)
+			return u
+		},
+		newList: func() client.ObjectList {
+			u := &unstructured.UnstructuredList{}
+			u.SetGroupVersionKind(util.JobSetGVK.GroupVersion().WithKind(util.JobSetGVK.Kind + "List"))
+			return u
+		},
+		minMember: jobSetMinMember,
+	},
+}
+
+// WorkloadReconciler creates a PodGroup for every opted-in Job, StatefulSet,
+// ReplicaSet and JobSet, keeps its MinMember in line with the workload size and
+// makes the workload its controller so that it is garbage collected along with it.
+// Workloads opt in through the AutoPodGroupKey annotation or namespace label.
+type WorkloadReconciler struct {
+	client.Client
+	Scheme  *runtime.Scheme
+	Workers int
+}
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=statefulsets;replicasets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=jobset.x-k8s.io,resources=jobsets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch;apps;jobset.x-k8s.io,resources=jobs/finalizers;statefulsets/finalizers;replicasets/finalizers;jobsets/finalizers,verbs=update
+
+// SetupWithManager sets up one controller per workload kind with the Manager.
+// JobSet is skipped when its CRD is not installed.
+func (r *WorkloadReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	for i := range workloadKinds {
+		kind := &workloadKinds[i]
+		if _, err := mgr.GetRESTMapper().RESTMapping(kind.gvk.GroupKind(), kind.gvk.Version); err != nil {
+			if meta.IsNoMatchError(err) {
+				mgr.GetLogger().Info("Workload kind not served, skipping automatic PodGroup creation", "kind", kind.gvk)
+				continue
+			}
+			return err
+		}
+		kr := &workloadKindReconciler{
+			Client:   r.Client,
+			scheme:   r.Scheme,
+			recorder: mgr.GetEventRecorderFor("WorkloadPodGroupController"),
+			kind:     kind,
+		}
+		if err := ctrl.NewControllerManagedBy(mgr).
+			Named("podgroup-"+strings.ToLower(kind.gvk.Kind)).
+			For(kind.newObj()).
+			Owns(&schedv1alpha1.PodGroup{}).
+			Watches(&v1.Namespace{}, handler.EnqueueRequestsFromMapFunc(kr.namespaceToWorkloads)).
+			WithOptions(controller.Options{MaxConcurrentReconciles: r.Workers}).
+			Complete(kr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// workloadKindReconciler reconciles the automatic PodGroup of a single workload kind.
+type workloadKindReconciler struct {
+	client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
+	kind     *workloadKind
+}
+
+func (r *workloadKindReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	obj := r.kind.newObj()
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrs.IsNotFound(err) {
+			// The PodGroup is garbage collected through its owner reference.
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	if obj.GetDeletionTimestamp() != nil {
+		return ctrl.Result{}, nil
+	}
+
+	enabled, err := util.AutoPodGroupEnabled(ctx, r, obj)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	pg := &schedv1alpha1.PodGroup{}
+	pgKey := client.ObjectKey{Namespace: obj.GetNamespace(), Name: util.AutoPodGroupName(r.kind.gvk.Kind, obj.GetName())}
+	if err := r.Get(ctx, pgKey, pg); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		pg = nil
+	}
+
+	if !enabled {
+		// Drop the PodGroup of a workload that opted out.
+		if pg != nil && metav1.IsControlledBy(pg, obj) {
+			log.V(3).Info("Deleting PodGroup of opted-out workload", "podGroup", pgKey)
+			return ctrl.Result{}, client.IgnoreNotFound(r.Delete(ctx, pg))
+		}
+		return ctrl.Result{}, nil
+	}
+
+	minMember, err := r.kind.minMember(obj)
+	if err != nil {
+		r.recorder.Event(obj, v1.EventTypeWarning, "InvalidWorkload", err.Error())
+		return ctrl.Result{}, nil
+	}
+	// A PodGroup needs at least one member: a workload scaled to zero keeps its
+	// current PodGroup, if any, until it scales up again.
+	if minMember < 1 {
+		return ctrl.Result{}, nil
+	}
+
+	if pg == nil {
+		pg = &schedv1alpha1.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{Namespace: pgKey.Namespace, Name: pgKey.Name},
+			Spec:       schedv1alpha1.PodGroupSpec{MinMember: minMember},
+		}
+		if err := controllerutil.SetControllerReference(obj, pg, r.scheme); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.V(3).Info("Creating PodGroup for workload", "podGroup", pgKey, "minMember", minMember)
+		return ctrl.Result{}, client.IgnoreAlreadyExists(r.Create(ctx, pg))
+	}
+	if !metav1.IsControlledBy(pg, obj) {
+		r.recorder.Eventf(obj, v1.EventTypeWarning, "PodGroupConflict",
+			"PodGroup %s exists and is not controlled by this %s", pgKey.Name, r.kind.gvk.Kind)
+		return ctrl.Result{}, nil
+	}
+	if pg.Spec.MinMember != minMember {
+		pgCopy := pg.DeepCopy()
+		pgCopy.Spec.MinMember = minMember
+		log.V(3).Info("Resizing PodGroup of workload", "podGroup", pgKey, "minMember", minMember)
+		if err := r.Patch(ctx, pgCopy, client.MergeFrom(pg)); err != nil {
+			// The admission webhook rejects resizing a partially bound gang: retry
+			// later rather than with a backoff growing as long as the gang runs.
+			if apierrs.IsForbidden(err) || apierrs.IsInvalid(err) {
+				r.recorder.Eventf(obj, v1.EventTypeWarning, "PodGroupResizeRejected",
+					"Resizing PodGroup %s to %d members was rejected, retrying in %v: %v", pgKey.Name, minMember, resizeRetryPeriod, err)
+				return ctrl.Result{RequeueAfter: resizeRetryPeriod}, nil
+			}
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+// namespaceToWorkloads enqueues the workloads of a namespace, whose opt-in may
+// depend on the namespace labels.
+func (r *workloadKindReconciler) namespaceToWorkloads(ctx context.Context, obj client.Object) []ctrl.Request {
+	list := r.kind.newList()
+	if err := r.List(ctx, list, client.InNamespace(obj.GetName())); err != nil {
+		log.FromContext(ctx).Error(err, "List workloads failed", "kind", r.kind.gvk.Kind, "namespace", obj.GetName())
+		return nil
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil
+	}
+	var requests []ctrl.Request
+	for _, item := range items {
+		o, ok := item.(client.Object)
+		if !ok {
+			continue
+		}
+		requests = append(requests, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(o)})
+	}
+	return requests
+}
+
+func replicasOrOne(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+// statefulSetMinMember returns the pods a StatefulSet runs at once: its replicas
+// with the Parallel pod management policy. With the default OrderedReady policy,
+// a pod is only created once the previous one is ready, so a gang of more than
+// one pod would never be complete.
+func statefulSetMinMember(obj client.Object) (int32, error) {
+	sts := obj.(*appsv1.StatefulSet)
+	if sts.Spec.PodManagementPolicy != appsv1.ParallelPodManagement {
+		return 1, nil
+	}
+	return replicasOrOne(sts.Spec.Replicas), nil
+}
+
+// jobMinMember returns the pods a Job runs at once: its parallelism, bounded by
+// its completions.
+func jobMinMember(obj client.Object) (int32, error) {
+	job := obj.(*batchv1.Job)
+	n := replicasOrOne(job.Spec.Parallelism)
+	if job.Spec.Completions != nil && *job.Spec.Completions < n {
+		n = *job.Spec.Completions
+	}
+	return n, nil
+}
+
+// jobSetMinMember returns the pods a JobSet runs at once: the sum over its
+// replicated jobs of replicas times parallelism.
+func jobSetMinMember(obj client.Object) (int32, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return 0, fmt.Errorf("want object to be unstructured, got %T", obj)
+	}
+	replicatedJobs, _, err := unstructured.NestedSlice(u.Object, "spec", "replicatedJobs")
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for i, rj := range replicatedJobs {
+		m, ok := rj.(map[string]interface{})
+		if !ok {
+			return 0, fmt.Errorf("spec.replicatedJobs[%d] is not an object", i)
+		}
+		replicas, err := nestedInt64OrOne(m, "replicas")
+		if err != nil {
+			return 0, fmt.Errorf("spec.replicatedJobs[%d]: %w", i, err)
+		}
+		parallelism, err := nestedInt64OrOne(m, "template", "spec", "parallelism")
+		if err != nil {
+			return 0, fmt.Errorf("spec.replicatedJobs[%d]: %w", i, err)
+		}
+		if completions, found, err := unstructured.NestedInt64(m, "template", "spec", "completions"); err == nil && found && completions < parallelism {
+			parallelism = completions
+		}
+		total += replicas * parallelism
+	}
+	return int32(total), nil
+}
+
+func nestedInt64OrOne(obj map[string]interface{}, fields ...string) (int64, error) {
+	v, found, err := unstructured.NestedInt64(obj, fields...)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 1, nil
+	}
+	return v, nil
+}
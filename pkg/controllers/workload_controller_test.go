@@ -0,0 +1,294 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/util"
+)
+
+func makeNamespace(name string, autoPodGroup bool) *v1.Namespace {
+	ns := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if autoPodGroup {
+		ns.Labels = map[string]string{v1alpha1.AutoPodGroupKey: "true"}
+	}
+	return ns
+}
+
+func makeJob(name string, parallelism, completions *int32, annotations map[string]string) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", UID: types.UID(name), Annotations: annotations},
+		Spec:       batchv1.JobSpec{Parallelism: parallelism, Completions: completions},
+	}
+}
+
+func makeStatefulSet(replicas int32, policy appsv1.PodManagementPolicyType) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "sts", Namespace: "ns", UID: "sts"},
+		Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To(replicas), PodManagementPolicy: policy},
+	}
+}
+
+func makeJobSet(name string, replicatedJobs ...interface{}) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": name, "namespace": "ns", "uid": name},
+		"spec":     map[string]interface{}{"replicatedJobs": replicatedJobs},
+	}}
+	u.SetGroupVersionKind(util.JobSetGVK)
+	return u
+}
+
+func makeReplicatedJob(replicas, parallelism int64) interface{} {
+	return map[string]interface{}{
+		"replicas": replicas,
+		"template": map[string]interface{}{"spec": map[string]interface{}{"parallelism": parallelism}},
+	}
+}
+
+func workloadKindFor(t *testing.T, kind string) *workloadKind {
+	for i := range workloadKinds {
+		if workloadKinds[i].gvk.Kind == kind {
+			return &workloadKinds[i]
+		}
+	}
+	t.Fatalf("unknown workload kind %s", kind)
+	return nil
+}
+
+func TestWorkloadReconcile(t *testing.T) {
+	ctx := context.TODO()
+	job := makeJob("job", ptr.To[int32](4), nil, nil)
+	cases := []struct {
+		name string
+		kind string
+		objs []client.Object
+		// existing returns a PodGroup present before the reconciliation.
+		existing      func(s *runtime.Scheme) *v1alpha1.PodGroup
+		workload      client.Object
+		wantMinMember int32
+	}{
+		{
+			name:          "job in opted-in namespace",
+			kind:          "Job",
+			objs:          []client.Object{makeNamespace("ns", true)},
+			workload:      job,
+			wantMinMember: 4,
+		},
+		{
+			name:     "job in namespace not opted in",
+			kind:     "Job",
+			objs:     []client.Object{makeNamespace("ns", false)},
+			workload: job,
+		},
+		{
+			name:          "annotated job bounded by completions",
+			kind:          "Job",
+			objs:          []client.Object{makeNamespace("ns", false)},
+			workload:      makeJob("job", ptr.To[int32](4), ptr.To[int32](2), map[string]string{v1alpha1.AutoPodGroupKey: "true"}),
+			wantMinMember: 2,
+		},
+		{
+			name:     "job opted out in opted-in namespace drops its PodGroup",
+			kind:     "Job",
+			objs:     []client.Object{makeNamespace("ns", true)},
+			workload: makeJob("job", ptr.To[int32](4), nil, map[string]string{v1alpha1.AutoPodGroupKey: "false"}),
+			existing: func(s *runtime.Scheme) *v1alpha1.PodGroup {
+				return makeOwnedPG(t, s, "job-job", 4, job)
+			},
+		},
+		{
+			name:          "resized parallel statefulset",
+			kind:          "StatefulSet",
+			objs:          []client.Object{makeNamespace("ns", true)},
+			workload:      makeStatefulSet(5, appsv1.ParallelPodManagement),
+			wantMinMember: 5,
+			existing: func(s *runtime.Scheme) *v1alpha1.PodGroup {
+				return makeOwnedPG(t, s, "statefulset-sts", 3, makeStatefulSet(3, appsv1.ParallelPodManagement))
+			},
+		},
+		{
+			name:          "ordered statefulset creating its pods one at a time",
+			kind:          "StatefulSet",
+			objs:          []client.Object{makeNamespace("ns", true)},
+			workload:      makeStatefulSet(5, appsv1.OrderedReadyPodManagement),
+			wantMinMember: 1,
+		},
+		{
+			name:          "statefulset defaulting to ordered pod management",
+			kind:          "StatefulSet",
+			objs:          []client.Object{makeNamespace("ns", true)},
+			workload:      makeStatefulSet(5, ""),
+			wantMinMember: 1,
+		},
+		{
+			name:          "job with the longest name",
+			kind:          "Job",
+			objs:          []client.Object{makeNamespace("ns", true)},
+			workload:      makeJob(strings.Repeat("j", 63), ptr.To[int32](2), nil, nil),
+			wantMinMember: 2,
+		},
+		{
+			name:          "replicaset defaulting to one replica",
+			kind:          "ReplicaSet",
+			objs:          []client.Object{makeNamespace("ns", true)},
+			workload:      &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "rs", Namespace: "ns", UID: "rs"}},
+			wantMinMember: 1,
+		},
+		{
+			name:          "jobset",
+			kind:          "JobSet",
+			objs:          []client.Object{makeNamespace("ns", true)},
+			workload:      makeJobSet("js", makeReplicatedJob(2, 3), makeReplicatedJob(1, 1)),
+			wantMinMember: 7,
+		},
+		{
+			name:     "PodGroup not controlled by the workload is left alone",
+			kind:     "Job",
+			objs:     []client.Object{makeNamespace("ns", true)},
+			workload: job,
+			existing: func(s *runtime.Scheme) *v1alpha1.PodGroup {
+				return &v1alpha1.PodGroup{
+					ObjectMeta: metav1.ObjectMeta{Name: "job-job", Namespace: "ns"},
+					Spec:       v1alpha1.PodGroupSpec{MinMember: 2},
+				}
+			},
+			wantMinMember: 2,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(s)
+			_ = v1alpha1.AddToScheme(s)
+			s.AddKnownTypeWithName(util.JobSetGVK, &unstructured.Unstructured{})
+
+			objs := append(c.objs, c.workload.DeepCopyObject().(client.Object))
+			if c.existing != nil {
+				objs = append(objs, c.existing(s))
+			}
+			cl := fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+			r := &workloadKindReconciler{
+				Client:   cl,
+				scheme:   s,
+				recorder: record.NewFakeRecorder(3),
+				kind:     workloadKindFor(t, c.kind),
+			}
+			key := client.ObjectKeyFromObject(c.workload)
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+				t.Fatalf("reconcile failed: %v", err)
+			}
+
+			pg := &v1alpha1.PodGroup{}
+			pgKey := types.NamespacedName{Namespace: "ns", Name: util.AutoPodGroupName(c.kind, key.Name)}
+			err := cl.Get(ctx, pgKey, pg)
+			if c.wantMinMember == 0 {
+				if !apierrs.IsNotFound(err) {
+					t.Fatalf("expected no PodGroup, got %v, %v", pg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to get PodGroup: %v", err)
+			}
+			if len(pg.Name) > validation.LabelValueMaxLength {
+				t.Errorf("expected PodGroup name %q to fit in a label value", pg.Name)
+			}
+			if pg.Spec.MinMember != c.wantMinMember {
+				t.Errorf("expected MinMember %d, got %d", c.wantMinMember, pg.Spec.MinMember)
+			}
+			if c.existing == nil {
+				ref := metav1.GetControllerOf(pg)
+				if ref == nil || ref.Kind != c.kind || ref.Name != key.Name {
+					t.Errorf("expected PodGroup to be controlled by %s %s, got %v", c.kind, key.Name, ref)
+				}
+			}
+		})
+	}
+}
+
+func makeOwnedPG(t *testing.T, s *runtime.Scheme, name string, minMember int32, owner client.Object) *v1alpha1.PodGroup {
+	pg := &v1alpha1.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Spec:       v1alpha1.PodGroupSpec{MinMember: minMember},
+	}
+	if err := ctrl.SetControllerReference(owner, pg, s); err != nil {
+		t.Fatal(err)
+	}
+	return pg
+}
+
+func TestWorkloadReconcileResizeRejected(t *testing.T) {
+	ctx := context.TODO()
+	s := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(s)
+	_ = v1alpha1.AddToScheme(s)
+
+	sts := makeStatefulSet(5, appsv1.ParallelPodManagement)
+	cl := fake.NewClientBuilder().WithScheme(s).
+		WithObjects(makeNamespace("ns", true), sts, makeOwnedPG(t, s, "statefulset-sts", 3, sts)).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				return apierrs.NewForbidden(v1alpha1.Resource("podgroups"), obj.GetName(), errors.New("gang partially bound"))
+			},
+		}).
+		Build()
+	recorder := record.NewFakeRecorder(1)
+	r := &workloadKindReconciler{
+		Client:   cl,
+		scheme:   s,
+		recorder: recorder,
+		kind:     workloadKindFor(t, "StatefulSet"),
+	}
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(sts)})
+	if err != nil {
+		t.Fatalf("reconcile failed: %v", err)
+	}
+	if result.RequeueAfter != resizeRetryPeriod {
+		t.Errorf("expected requeue after %v, got %v", resizeRetryPeriod, result.RequeueAfter)
+	}
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, "PodGroupResizeRejected") {
+			t.Errorf("unexpected event %q", event)
+		}
+	default:
+		t.Error("expected a PodGroupResizeRejected event")
+	}
+}
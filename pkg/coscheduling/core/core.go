@@ -37,6 +37,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/debug"
 	"sigs.k8s.io/scheduler-plugins/pkg/util"
 )
 
@@ -73,6 +74,16 @@ type Manager interface {
 	DeletePermittedPodGroup(context.Context, string)
 	ActivateSiblings(ctx context.Context, pod *corev1.Pod, state *framework.CycleState)
 	BackoffPodGroup(string, time.Duration)
+	DumpState() *debug.CoschedulingState
+}
+
+// PodGroupResourceChecker is an additional resource check run in PreFilter before a PodGroup
+// is permitted. It allows to take into account constraints the cluster-wide resource check
+// cannot see, like the NUMA fragmentation of the nodes.
+type PodGroupResourceChecker interface {
+	// CheckPodGroupResource returns an error if the members of <pg>, using <pod> as their template,
+	// cannot be placed on the nodes in <nodeList>.
+	CheckPodGroupResource(ctx context.Context, nodeList []*framework.NodeInfo, pg *v1alpha1.PodGroup, pod *corev1.Pod) error
 }
 
 // PodGroupManager defines the scheduling operation called
@@ -92,6 +103,8 @@ type PodGroupManager struct {
 	podLister listerv1.PodLister
 	// assignedPodsByPG stores the pods assumed or bound for podgroups
 	assignedPodsByPG map[string]sets.Set[string]
+	// resourceCheckers are the additional resource checks to run before permitting a podgroup.
+	resourceCheckers []PodGroupResourceChecker
 	sync.RWMutex
 }
 
@@ -119,7 +132,7 @@ func AddPodFactory(pgMgr *PodGroupManager) func(obj interface{}) {
 }
 
 // NewPodGroupManager creates a new operation object.
-func NewPodGroupManager(client client.Client, snapshotSharedLister framework.SharedLister, scheduleTimeout *time.Duration, podInformer informerv1.PodInformer) *PodGroupManager {
+func NewPodGroupManager(client client.Client, snapshotSharedLister framework.SharedLister, scheduleTimeout *time.Duration, podInformer informerv1.PodInformer, resourceCheckers ...PodGroupResourceChecker) *PodGroupManager {
 	pgMgr := &PodGroupManager{
 		client:               client,
 		snapshotSharedLister: snapshotSharedLister,
@@ -128,6 +141,7 @@ func NewPodGroupManager(client client.Client, snapshotSharedLister framework.Sha
 		permittedPG:          gocache.New(3*time.Second, 3*time.Second),
 		backedOffPG:          gocache.New(10*time.Second, 10*time.Second),
 		assignedPodsByPG:     map[string]sets.Set[string]{},
+		resourceCheckers:     resourceCheckers,
 	}
 	podInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: AddPodFactory(pgMgr),
@@ -219,7 +233,8 @@ func (pgMgr *PodGroupManager) ActivateSiblings(ctx context.Context, pod *corev1.
 // PreFilter filters out a pod if
 // 1. it belongs to a podgroup that was recently denied or
 // 2. the total number of pods in the podgroup is less than the minimum number of pods
-// that is required to be scheduled.
+// that is required to be scheduled or
+// 3. the cluster cannot satisfy the minResources of the podgroup or any of the additional resource checks.
 func (pgMgr *PodGroupManager) PreFilter(ctx context.Context, pod *corev1.Pod) error {
 	lh := klog.FromContext(ctx)
 	lh.V(5).Info("Pre-filter", "pod", klog.KObj(pod))
@@ -244,7 +259,7 @@ func (pgMgr *PodGroupManager) PreFilter(ctx context.Context, pod *corev1.Pod) er
 			"current pods number: %v, minMember of group: %v", pod.Name, len(pods), pg.Spec.MinMember)
 	}
 
-	if pg.Spec.MinResources == nil {
+	if pg.Spec.MinResources == nil && len(pgMgr.resourceCheckers) == 0 {
 		return nil
 	}
 
@@ -260,13 +275,22 @@ func (pgMgr *PodGroupManager) PreFilter(ctx context.Context, pod *corev1.Pod) er
 		return err
 	}
 
-	minResources := pg.Spec.MinResources.DeepCopy()
-	podQuantity := resource.NewQuantity(int64(pg.Spec.MinMember), resource.DecimalSI)
-	minResources[corev1.ResourcePods] = *podQuantity
-	err = CheckClusterResource(ctx, nodes, minResources, pgFullName)
-	if err != nil {
-		lh.Error(err, "Failed to PreFilter", "podGroup", klog.KObj(pg))
-		return err
+	if pg.Spec.MinResources != nil {
+		minResources := pg.Spec.MinResources.DeepCopy()
+		podQuantity := resource.NewQuantity(int64(pg.Spec.MinMember), resource.DecimalSI)
+		minResources[corev1.ResourcePods] = *podQuantity
+		err = CheckClusterResource(ctx, nodes, minResources, pgFullName)
+		if err != nil {
+			lh.Error(err, "Failed to PreFilter", "podGroup", klog.KObj(pg))
+			return err
+		}
+	}
+
+	for _, checker := range pgMgr.resourceCheckers {
+		if err := checker.CheckPodGroupResource(ctx, nodes, pg, pod); err != nil {
+			lh.Error(err, "Failed to PreFilter", "podGroup", klog.KObj(pg))
+			return err
+		}
 	}
 	pgMgr.permittedPG.Add(pgFullName, pgFullName, *pgMgr.scheduleTimeout)
 	return nil
@@ -343,6 +367,51 @@ func (pgMgr *PodGroupManager) GetCreationTimestamp(ctx context.Context, pod *cor
 	return pg.CreationTimestamp.Time
 }
 
+// DumpState returns the pods assigned to each PodGroup and the PodGroups permitted or backed off,
+// with the time their permission or backoff expires, along with these PodGroups.
+func (pgMgr *PodGroupManager) DumpState() *debug.CoschedulingState {
+	state := &debug.CoschedulingState{
+		AssignedPods:       make(map[string][]string),
+		PermittedPodGroups: expirations(pgMgr.permittedPG),
+		BackedOffPodGroups: expirations(pgMgr.backedOffPG),
+	}
+	pgFullNames := sets.KeySet(state.PermittedPodGroups).Union(sets.KeySet(state.BackedOffPodGroups))
+	pgMgr.RWMutex.RLock()
+	for pgFullName, pods := range pgMgr.assignedPodsByPG {
+		state.AssignedPods[pgFullName] = sets.List(pods)
+		pgFullNames.Insert(pgFullName)
+	}
+	pgMgr.RWMutex.RUnlock()
+
+	// The PodGroups deleted since are skipped.
+	for _, pgFullName := range sets.List(pgFullNames) {
+		namespace, name, err := cache.SplitMetaNamespaceKey(pgFullName)
+		if err != nil {
+			continue
+		}
+		var pg v1alpha1.PodGroup
+		if err := pgMgr.client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, &pg); err != nil {
+			continue
+		}
+		state.PodGroups = append(state.PodGroups, pg)
+	}
+	return state
+}
+
+// expirations returns the time each unexpired item of the cache expires, by key. The items which
+// never expire get a zero time.
+func expirations(c *gocache.Cache) map[string]metav1.Time {
+	result := make(map[string]metav1.Time)
+	for key, item := range c.Items() {
+		var expiration metav1.Time
+		if item.Expiration > 0 {
+			expiration = metav1.NewTime(time.Unix(0, item.Expiration))
+		}
+		result[key] = expiration
+	}
+	return result
+}
+
 // DeletePermittedPodGroup deletes a podGroup that passes Pre-Filter but reaches PostFilter.
 func (pgMgr *PodGroupManager) DeletePermittedPodGroup(_ context.Context, pgFullName string) {
 	pgMgr.permittedPG.Delete(pgFullName)
@@ -18,9 +18,11 @@ package core
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	gocache "github.com/patrickmn/go-cache"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -50,6 +52,7 @@ func TestPreFilter(t *testing.T) {
 		pod             *corev1.Pod
 		pendingPods     []*corev1.Pod
 		pgs             []*v1alpha1.PodGroup
+		checkers        []PodGroupResourceChecker
 		expectedSuccess bool
 	}{
 		{
@@ -139,6 +142,46 @@ func TestPreFilter(t *testing.T) {
 			},
 			expectedSuccess: false,
 		},
+		{
+			name: "resource checker permits the pg without minResource",
+			pod:  st.MakePod().Name("p1a").Namespace("ns").UID("p1a").Label(v1alpha1.PodGroupLabel, "pg1").Obj(),
+			pendingPods: []*corev1.Pod{
+				st.MakePod().Name("p1b").Namespace("ns").UID("p1b").Label(v1alpha1.PodGroupLabel, "pg1").Obj(),
+				st.MakePod().Name("p1c").Namespace("ns").UID("p1c").Label(v1alpha1.PodGroupLabel, "pg1").Obj(),
+			},
+			pgs: []*v1alpha1.PodGroup{
+				tu.MakePodGroup().Name("pg1").Namespace("ns").MinMember(2).Obj(),
+			},
+			checkers:        []PodGroupResourceChecker{&fakeResourceChecker{}},
+			expectedSuccess: true,
+		},
+		{
+			name: "resource checker rejects the pg without minResource",
+			pod:  st.MakePod().Name("p1a").Namespace("ns").UID("p1a").Label(v1alpha1.PodGroupLabel, "pg1").Obj(),
+			pendingPods: []*corev1.Pod{
+				st.MakePod().Name("p1b").Namespace("ns").UID("p1b").Label(v1alpha1.PodGroupLabel, "pg1").Obj(),
+				st.MakePod().Name("p1c").Namespace("ns").UID("p1c").Label(v1alpha1.PodGroupLabel, "pg1").Obj(),
+			},
+			pgs: []*v1alpha1.PodGroup{
+				tu.MakePodGroup().Name("pg1").Namespace("ns").MinMember(2).Obj(),
+			},
+			checkers:        []PodGroupResourceChecker{&fakeResourceChecker{err: fmt.Errorf("NUMA zones gap")}},
+			expectedSuccess: false,
+		},
+		{
+			name: "resource checker rejects the pg satisfying minResource",
+			pod:  st.MakePod().Name("p1a").Namespace("ns").UID("p1a").Label(v1alpha1.PodGroupLabel, "pg1").Obj(),
+			pendingPods: []*corev1.Pod{
+				st.MakePod().Name("p1b").Namespace("ns").UID("p1b").Label(v1alpha1.PodGroupLabel, "pg1").Obj(),
+				st.MakePod().Name("p1c").Namespace("ns").UID("p1c").Label(v1alpha1.PodGroupLabel, "pg1").Obj(),
+			},
+			pgs: []*v1alpha1.PodGroup{
+				tu.MakePodGroup().Name("pg1").Namespace("ns").MinMember(2).
+					MinResources(map[corev1.ResourceName]string{corev1.ResourceCPU: "6"}).Obj(),
+			},
+			checkers:        []PodGroupResourceChecker{&fakeResourceChecker{}, &fakeResourceChecker{err: fmt.Errorf("NUMA zones gap")}},
+			expectedSuccess: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -172,6 +215,7 @@ func TestPreFilter(t *testing.T) {
 				permittedPG:          newCache(),
 				backedOffPG:          newCache(),
 				assignedPodsByPG:     make(map[string]sets.Set[string]),
+				resourceCheckers:     tt.checkers,
 			}
 
 			informerFactory.Start(ctx.Done())
@@ -190,6 +234,14 @@ func TestPreFilter(t *testing.T) {
 	}
 }
 
+type fakeResourceChecker struct {
+	err error
+}
+
+func (f *fakeResourceChecker) CheckPodGroupResource(_ context.Context, _ []*framework.NodeInfo, _ *v1alpha1.PodGroup, _ *corev1.Pod) error {
+	return f.err
+}
+
 func TestPermit(t *testing.T) {
 	scheduleTimeout := 10 * time.Second
 	capacity := map[corev1.ResourceName]string{
@@ -286,6 +338,38 @@ func TestPermit(t *testing.T) {
 	}
 }
 
+func TestDumpState(t *testing.T) {
+	scheduleTimeout := 10 * time.Second
+	pg1 := tu.MakePG("pg1", "ns", 2, nil, nil)
+	client, err := tu.NewFakeClient(pg1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs := clientsetfake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(cs, 0)
+	pgMgr := NewPodGroupManager(client, tu.NewFakeSharedLister(nil, nil), &scheduleTimeout, informerFactory.Core().V1().Pods())
+
+	pgMgr.assignedPodsByPG["ns/pg1"] = sets.New("p1b", "p1a")
+	before := time.Now()
+	pgMgr.permittedPG.Add("ns/pg1", "ns/pg1", scheduleTimeout)
+	pgMgr.BackoffPodGroup("ns/pg2", time.Minute)
+
+	state := pgMgr.DumpState()
+	if diff := cmp.Diff(map[string][]string{"ns/pg1": {"p1a", "p1b"}}, state.AssignedPods); diff != "" {
+		t.Errorf("Unexpected assigned pods (-want,+got):\n%s", diff)
+	}
+	if expiration, ok := state.PermittedPodGroups["ns/pg1"]; !ok || expiration.Time.Before(before.Add(scheduleTimeout)) {
+		t.Errorf("Want ns/pg1 permitted for %v, got %v", scheduleTimeout, state.PermittedPodGroups)
+	}
+	if expiration, ok := state.BackedOffPodGroups["ns/pg2"]; !ok || expiration.Time.Before(before.Add(time.Minute)) {
+		t.Errorf("Want ns/pg2 backed off for %v, got %v", time.Minute, state.BackedOffPodGroups)
+	}
+	// ns/pg2 does not exist anymore.
+	if len(state.PodGroups) != 1 || state.PodGroups[0].Name != "pg1" || state.PodGroups[0].Spec.MinMember != 2 {
+		t.Errorf("Want PodGroup ns/pg1 dumped, got %v", state.PodGroups)
+	}
+}
+
 func TestCheckClusterResource(t *testing.T) {
 	capacity := map[corev1.ResourceName]string{
 		corev1.ResourceCPU: "3",
@@ -19,6 +19,8 @@ package coscheduling
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sync"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
@@ -32,9 +34,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
 	"sigs.k8s.io/scheduler-plugins/apis/scheduling"
 	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
 	"sigs.k8s.io/scheduler-plugins/pkg/coscheduling/core"
+	"sigs.k8s.io/scheduler-plugins/pkg/debug"
+	"sigs.k8s.io/scheduler-plugins/pkg/metrics"
+	"sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology"
 	"sigs.k8s.io/scheduler-plugins/pkg/util"
 )
 
@@ -44,6 +50,8 @@ type Coscheduling struct {
 	pgMgr            core.Manager
 	scheduleTimeout  *time.Duration
 	pgBackoff        *time.Duration
+	// gangWaitStart maps the full name of a gang to the time its first member waited in Permit.
+	gangWaitStart sync.Map
 }
 
 var _ framework.QueueSortPlugin = &Coscheduling{}
@@ -53,6 +61,8 @@ var _ framework.PermitPlugin = &Coscheduling{}
 var _ framework.ReservePlugin = &Coscheduling{}
 
 var _ framework.EnqueueExtensions = &Coscheduling{}
+var _ debug.StateDumper = &Coscheduling{}
+var _ debug.HandlerProvider = &Coscheduling{}
 
 const (
 	// Name is the name of the plugin used in Registry and configurations.
@@ -69,6 +79,10 @@ func New(ctx context.Context, obj runtime.Object, handle framework.Handle) (fram
 	if !ok {
 		return nil, fmt.Errorf("want args to be of type CoschedulingArgs, got %T", obj)
 	}
+	if err := validation.ValidateCoschedulingArgs(nil, args); err != nil {
+		return nil, err
+	}
+	metrics.Register()
 
 	scheme := runtime.NewScheme()
 	_ = clientscheme.AddToScheme(scheme)
@@ -82,6 +96,12 @@ func New(ctx context.Context, obj runtime.Object, handle framework.Handle) (fram
 	// Performance improvement when retrieving list of objects by namespace or we'll log 'index not exist' warning.
 	handle.SharedInformerFactory().Core().V1().Pods().Informer().AddIndexers(cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
 
+	var resourceCheckers []core.PodGroupResourceChecker
+	if args.NUMAAwareAdmission {
+		lh.V(3).Info("enable NUMA aware admission of pod groups")
+		resourceCheckers = append(resourceCheckers, noderesourcetopology.NewGangCheckerFromHandle(ctx, lh, handle))
+	}
+
 	scheduleTimeDuration := time.Duration(args.PermitWaitingTimeSeconds) * time.Second
 	pgMgr := core.NewPodGroupManager(
 		client,
@@ -89,17 +109,14 @@ func New(ctx context.Context, obj runtime.Object, handle framework.Handle) (fram
 		&scheduleTimeDuration,
 		// Keep the podInformer (from frameworkHandle) as the single source of Pods.
 		handle.SharedInformerFactory().Core().V1().Pods(),
+		resourceCheckers...,
 	)
 	plugin := &Coscheduling{
 		frameworkHandler: handle,
 		pgMgr:            pgMgr,
 		scheduleTimeout:  &scheduleTimeDuration,
 	}
-	if args.PodGroupBackoffSeconds < 0 {
-		err := fmt.Errorf("parse arguments failed")
-		lh.Error(err, "PodGroupBackoffSeconds cannot be negative")
-		return nil, err
-	} else if args.PodGroupBackoffSeconds > 0 {
+	if args.PodGroupBackoffSeconds > 0 {
 		pgBackoff := time.Duration(args.PodGroupBackoffSeconds) * time.Second
 		plugin.pgBackoff = &pgBackoff
 	}
@@ -122,6 +139,18 @@ func (cs *Coscheduling) Name() string {
 	return Name
 }
 
+// DumpState records the state of the PodGroupManager.
+func (cs *Coscheduling) DumpState(state *debug.ProfileState) {
+	state.Coscheduling = cs.pgMgr.DumpState()
+}
+
+// DebugHandlers serves the pods assigned to each PodGroup and the PodGroups permitted or backed off.
+func (cs *Coscheduling) DebugHandlers() map[string]http.Handler {
+	return map[string]http.Handler{
+		"podgroups": debug.JSONHandler(func() interface{} { return cs.pgMgr.DumpState() }),
+	}
+}
+
 // Less is used to sort pods in the scheduling queue in the following order.
 // 1. Compare the priorities of Pods.
 // 2. Compare the initialization timestamps of PodGroups or Pods.
@@ -149,6 +178,7 @@ func (cs *Coscheduling) PreFilter(ctx context.Context, state *framework.CycleSta
 	// any preemption attempts.
 	if err := cs.pgMgr.PreFilter(ctx, pod); err != nil {
 		lh.Error(err, "PreFilter failed", "pod", klog.KObj(pod))
+		metrics.CoschedulingRejectionsTotal.WithLabelValues(metrics.RejectionReasonPreFilter).Inc()
 		return nil, framework.NewStatus(framework.UnschedulableAndUnresolvable, err.Error())
 	}
 	return nil, framework.NewStatus(framework.Success, "")
@@ -199,6 +229,8 @@ func (cs *Coscheduling) PostFilter(ctx context.Context, state *framework.CycleSt
 	}
 
 	cs.pgMgr.DeletePermittedPodGroup(ctx, pgName)
+	metrics.CoschedulingRejectionsTotal.WithLabelValues(metrics.RejectionReasonPostFilter).Inc()
+	cs.observeGangWait(pgName, metrics.GangResultRejected)
 	return &framework.PostFilterResult{}, framework.NewStatus(framework.Unschedulable,
 		fmt.Sprintf("PodGroup %v gets rejected due to Pod %v is unschedulable even after PostFilter", pgName, pod.Name))
 }
@@ -218,6 +250,7 @@ func (cs *Coscheduling) Permit(ctx context.Context, state *framework.CycleState,
 	case core.PodGroupNotSpecified:
 		return framework.NewStatus(framework.Success, ""), 0
 	case core.PodGroupNotFound:
+		metrics.CoschedulingRejectionsTotal.WithLabelValues(metrics.RejectionReasonPermit).Inc()
 		return framework.NewStatus(framework.Unschedulable, "PodGroup not found"), 0
 	case core.Wait:
 		lh.Info("Pod is waiting to be scheduled to node", "pod", klog.KObj(pod), "nodeName", nodeName)
@@ -226,6 +259,7 @@ func (cs *Coscheduling) Permit(ctx context.Context, state *framework.CycleState,
 			waitTime = wait
 		}
 		retStatus = framework.NewStatus(framework.Wait)
+		cs.gangWaitStart.LoadOrStore(util.GetPodGroupFullName(pod), time.Now())
 		// We will also request to move the sibling pods back to activeQ.
 		cs.pgMgr.ActivateSiblings(ctx, pod, state)
 	case core.Success:
@@ -237,6 +271,7 @@ func (cs *Coscheduling) Permit(ctx context.Context, state *framework.CycleState,
 			}
 		})
 		lh.V(3).Info("Permit allows", "pod", klog.KObj(pod))
+		cs.observeGangWait(pgFullName, metrics.GangResultAllowed)
 		retStatus = framework.NewStatus(framework.Success)
 		waitTime = 0
 	}
@@ -257,6 +292,8 @@ func (cs *Coscheduling) Unreserve(ctx context.Context, state *framework.CycleSta
 		return
 	}
 	cs.pgMgr.Unreserve(ctx, pod)
+	metrics.CoschedulingRejectionsTotal.WithLabelValues(metrics.RejectionReasonUnreserve).Inc()
+	cs.observeGangWait(pgName, metrics.GangResultRejected)
 	cs.frameworkHandler.IterateOverWaitingPods(func(waitingPod framework.WaitingPod) {
 		if waitingPod.GetPod().Namespace == pod.Namespace && util.GetPodGroupLabel(waitingPod.GetPod()) == pg.Name {
 			lh.V(3).Info("Unreserve rejects", "pod", klog.KObj(waitingPod.GetPod()), "podGroup", klog.KObj(pg))
@@ -265,3 +302,11 @@ func (cs *Coscheduling) Unreserve(ctx context.Context, state *framework.CycleSta
 	})
 	cs.pgMgr.DeletePermittedPodGroup(ctx, pgName)
 }
+
+// observeGangWait records how long the gang waited in Permit, if any of its
+// members did, and resets its wait.
+func (cs *Coscheduling) observeGangWait(pgFullName, result string) {
+	if start, ok := cs.gangWaitStart.LoadAndDelete(pgFullName); ok {
+		metrics.CoschedulingGangWaitDuration.WithLabelValues(result).Observe(time.Since(start.(time.Time)).Seconds())
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"k8s.io/klog/v2"
+)
+
+// PluginPathPrefix is the path of the secure port the handlers of the plugins are served under,
+// followed by the name of the profile, the name of the plugin and the path of the handler.
+const PluginPathPrefix = "/debug/scheduler-plugins/profiles/"
+
+// HandlerProvider is implemented by the plugins serving their state.
+type HandlerProvider interface {
+	// DebugHandlers returns the read-only handlers serving the state of the plugin, by path
+	// relative to the one of the plugin.
+	DebugHandlers() map[string]http.Handler
+}
+
+// PluginPath returns the path the handler of the plugin of the profile is served on.
+func PluginPath(profileName, pluginName, path string) string {
+	return PluginPathPrefix + profileName + "/" + pluginName + "/" + path
+}
+
+// JSONHandler returns a handler serving what get returns as indented JSON. It only allows GET
+// requests, so that the state cannot be changed through it.
+func JSONHandler(get func() interface{}) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			http.Error(w, "only GET is allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(get()); err != nil {
+			klog.FromContext(req.Context()).Error(err, "Unable to write the state of the plugin", "path", req.URL.Path)
+		}
+	})
+}
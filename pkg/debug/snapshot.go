@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package debug exposes the internal state of the stateful scheduler plugins, so that the
+// scheduler can dump it to reproduce scheduling bugs, e.g. as fixtures of the integration tests.
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+
+	topologyv1alpha2 "github.com/k8stopologyawareschedwg/noderesourcetopology-api/pkg/apis/topology/v1alpha2"
+	"github.com/paypal/load-watcher/pkg/watcher"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/util"
+)
+
+// SnapshotVersion is the version of the snapshot format. It changes whenever the format changes in
+// a way older snapshots cannot be read with.
+const SnapshotVersion = "v1"
+
+// Snapshot is the state of the stateful plugins of a scheduler at a point in time.
+type Snapshot struct {
+	// Version is the version of the snapshot format, SnapshotVersion when written.
+	Version string `json:"version"`
+	// Time is when the snapshot was taken.
+	Time metav1.Time `json:"time"`
+	// Profiles are the states of the plugins of each scheduler profile, by scheduler name.
+	Profiles map[string]*ProfileState `json:"profiles"`
+}
+
+// ProfileState is the state of the stateful plugins of a scheduler profile. Only the plugins enabled in
+// the profile are set.
+type ProfileState struct {
+	Coscheduling         *CoschedulingState         `json:"coscheduling,omitempty"`
+	CapacityScheduling   *CapacitySchedulingState   `json:"capacityScheduling,omitempty"`
+	NodeResourceTopology *NodeResourceTopologyState `json:"nodeResourceTopology,omitempty"`
+	// Trimaran are the states of the Trimaran plugins, by plugin name.
+	Trimaran map[string]*TrimaranState `json:"trimaran,omitempty"`
+	SySched  *SySchedState             `json:"sySched,omitempty"`
+}
+
+// CoschedulingState is the state of the PodGroupManager of the Coscheduling plugin.
+type CoschedulingState struct {
+	// AssignedPods are the names of the pods assumed or bound, by PodGroup full name.
+	AssignedPods map[string][]string `json:"assignedPods,omitempty"`
+	// PermittedPodGroups are the PodGroups which passed the resource check, by full name, with the
+	// time their permission expires.
+	PermittedPodGroups map[string]metav1.Time `json:"permittedPodGroups,omitempty"`
+	// BackedOffPodGroups are the PodGroups which recently failed to be scheduled, by full name,
+	// with the time their backoff expires.
+	BackedOffPodGroups map[string]metav1.Time `json:"backedOffPodGroups,omitempty"`
+	// PodGroups are the PodGroups above, as read when the state was dumped.
+	PodGroups []v1alpha1.PodGroup `json:"podGroups,omitempty"`
+}
+
+// CapacitySchedulingState is the state of the CapacityScheduling plugin.
+type CapacitySchedulingState struct {
+	// ElasticQuotas are the quotas the plugin accounts, by namespace.
+	ElasticQuotas map[string]*ElasticQuotaState `json:"elasticQuotas,omitempty"`
+}
+
+// ElasticQuotaState is the accounting of an ElasticQuota by the CapacityScheduling plugin. The
+// resources missing from the min and max of the quota are set to their lower and upper bound.
+type ElasticQuotaState struct {
+	// Name is the name of the quota, in the namespace the state is keyed by.
+	Name string              `json:"name,omitempty"`
+	Min  *framework.Resource `json:"min"`
+	Max  *framework.Resource `json:"max"`
+	Used *framework.Resource `json:"used"`
+	// Pods are the names of the pods accounted in the quota.
+	Pods []string `json:"pods,omitempty"`
+}
+
+// NodeResourceTopologyState is the state of the NodeResourceTopology cache.
+type NodeResourceTopologyState struct {
+	// Generation is the generation of the cache, increased on every resync.
+	Generation uint64 `json:"generation"`
+	// NodeResourceTopologies are the cached NRTs, as of the last resync of their node.
+	NodeResourceTopologies []topologyv1alpha2.NodeResourceTopology `json:"nodeResourceTopologies,omitempty"`
+	// AssumedResources are the resources of the pods scheduled since the last resync, by node
+	// name then pod namespace/name.
+	AssumedResources map[string]map[string]v1.ResourceList `json:"assumedResources,omitempty"`
+}
+
+// NodeResourceTopologyDesyncState are the nodes the NodeResourceTopology cache is going to resync.
+type NodeResourceTopologyDesyncState struct {
+	// Generation is the generation of the cache, increased on every resync.
+	Generation uint64 `json:"generation"`
+	// MaybeOverReserved are the nodes filtered out since their last resync, which may be due to the
+	// resources assumed on them.
+	MaybeOverReserved []string `json:"maybeOverReserved,omitempty"`
+	// ForeignPods are the nodes running pods of other schedulers since their last resync.
+	ForeignPods []string `json:"foreignPods,omitempty"`
+	// ConfigChanged are the nodes whose NRT attributes, e.g. the topology manager configuration,
+	// changed since their last resync.
+	ConfigChanged []string `json:"configChanged,omitempty"`
+}
+
+// TrimaranState is the state of the load watcher metrics collector of a Trimaran plugin.
+type TrimaranState struct {
+	// Metrics are the metrics last collected from the load watcher.
+	Metrics watcher.WatcherMetrics `json:"metrics"`
+}
+
+// SySchedState is the state of the SySched plugin.
+type SySchedState struct {
+	// HostSyscalls are the system calls used by the pods of each node, by node name.
+	HostSyscalls map[string][]string `json:"hostSyscalls,omitempty"`
+}
+
+// StateDumper is implemented by the stateful plugins.
+type StateDumper interface {
+	// DumpState records the state of the plugin in the state of its profile.
+	DumpState(state *ProfileState)
+}
+
+// WriteSnapshot writes the snapshot as indented JSON.
+func WriteSnapshot(w io.Writer, snapshot *Snapshot) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(snapshot)
+}
+
+// ReadSnapshot reads a snapshot, failing if it was written in another version of the format.
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+	if err := json.NewDecoder(r).Decode(snapshot); err != nil {
+		return nil, err
+	}
+	if snapshot.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %q, want %q", snapshot.Version, SnapshotVersion)
+	}
+	return snapshot, nil
+}
+
+// ReadSnapshotFile reads a snapshot from a file.
+func ReadSnapshotFile(path string) (*Snapshot, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadSnapshot(f)
+}
+
+// Quotas returns the ElasticQuotas accounted by the plugin, sorted by namespace. The resources at
+// their lower bound of min or upper bound of max are left out, as in the quotas they were built
+// from.
+func (s *CapacitySchedulingState) Quotas() []*v1alpha1.ElasticQuota {
+	var quotas []*v1alpha1.ElasticQuota
+	for _, namespace := range sets.List(sets.KeySet(s.ElasticQuotas)) {
+		state := s.ElasticQuotas[namespace]
+		quotas = append(quotas, &v1alpha1.ElasticQuota{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: state.Name},
+			Spec: v1alpha1.ElasticQuotaSpec{
+				Min: resourceList(state.Min, 0),
+				Max: resourceList(state.Max, math.MaxInt64),
+			},
+			Status: v1alpha1.ElasticQuotaStatus{Used: resourceList(state.Used, math.MaxInt64)},
+		})
+	}
+	return quotas
+}
+
+// resourceList converts the resource to a list, leaving out the pods, which quotas do not bound, and
+// the quantities equal to bound.
+func resourceList(r *framework.Resource, bound int64) v1.ResourceList {
+	list := v1.ResourceList{}
+	if r == nil {
+		return list
+	}
+	for name, quantity := range util.ResourceList(r) {
+		value := quantity.Value()
+		if name == v1.ResourceCPU {
+			value = quantity.MilliValue()
+		}
+		if name != v1.ResourcePods && value != bound {
+			list[name] = quantity
+		}
+	}
+	return list
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	snapshot := &Snapshot{
+		Version: SnapshotVersion,
+		Time:    metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Profiles: map[string]*ProfileState{
+			"default-scheduler": {
+				Coscheduling: &CoschedulingState{
+					AssignedPods:       map[string][]string{"ns/pg": {"p1", "p2"}},
+					PermittedPodGroups: map[string]metav1.Time{"ns/pg": metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 10, 0, time.UTC))},
+				},
+				SySched: &SySchedState{HostSyscalls: map[string][]string{"node": {"read", "write"}}},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteSnapshot(&buf, snapshot); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadSnapshot(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(snapshot, got); diff != "" {
+		t.Errorf("Unexpected snapshot (-want,+got):\n%s", diff)
+	}
+}
+
+func TestReadSnapshotVersion(t *testing.T) {
+	if _, err := ReadSnapshot(strings.NewReader(`{"version":"v0","profiles":{}}`)); err == nil {
+		t.Error("Want an error reading a snapshot of another version")
+	}
+}
+
+func TestQuotas(t *testing.T) {
+	state := &CapacitySchedulingState{
+		ElasticQuotas: map[string]*ElasticQuotaState{
+			"ns2": {
+				Name: "quota",
+				Min:  &framework.Resource{MilliCPU: 0, Memory: 0, EphemeralStorage: 0},
+				Max:  &framework.Resource{MilliCPU: math.MaxInt64, Memory: math.MaxInt64, EphemeralStorage: math.MaxInt64},
+				Used: &framework.Resource{},
+			},
+			"ns1": {
+				Name: "eq1",
+				Min:  &framework.Resource{MilliCPU: 1000, Memory: 0, EphemeralStorage: 0},
+				Max:  &framework.Resource{MilliCPU: 2000, Memory: 1024, EphemeralStorage: math.MaxInt64},
+				Used: &framework.Resource{MilliCPU: 500, Memory: 512},
+			},
+		},
+	}
+
+	want := []*v1alpha1.ElasticQuota{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "eq1"},
+			Spec: v1alpha1.ElasticQuotaSpec{
+				Min: v1.ResourceList{v1.ResourceCPU: *resource.NewMilliQuantity(1000, resource.DecimalSI)},
+				Max: v1.ResourceList{
+					v1.ResourceCPU:    *resource.NewMilliQuantity(2000, resource.DecimalSI),
+					v1.ResourceMemory: *resource.NewQuantity(1024, resource.BinarySI),
+				},
+			},
+			Status: v1alpha1.ElasticQuotaStatus{Used: v1.ResourceList{
+				v1.ResourceCPU:              *resource.NewMilliQuantity(500, resource.DecimalSI),
+				v1.ResourceMemory:           *resource.NewQuantity(512, resource.BinarySI),
+				v1.ResourceEphemeralStorage: *resource.NewQuantity(0, resource.BinarySI),
+			}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "quota"},
+			Spec:       v1alpha1.ElasticQuotaSpec{Min: v1.ResourceList{}, Max: v1.ResourceList{}},
+			Status: v1alpha1.ElasticQuotaStatus{Used: v1.ResourceList{
+				v1.ResourceCPU:              *resource.NewMilliQuantity(0, resource.DecimalSI),
+				v1.ResourceMemory:           *resource.NewQuantity(0, resource.BinarySI),
+				v1.ResourceEphemeralStorage: *resource.NewQuantity(0, resource.BinarySI),
+			}},
+		},
+	}
+	got := state.Quotas()
+	if diff := cmp.Diff(want, got, cmp.Comparer(func(a, b resource.Quantity) bool { return a.Cmp(b) == 0 })); diff != "" {
+		t.Errorf("Unexpected quotas (-want,+got):\n%s", diff)
+	}
+}
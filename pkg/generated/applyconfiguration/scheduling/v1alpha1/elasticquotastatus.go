@@ -20,12 +20,17 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // ElasticQuotaStatusApplyConfiguration represents a declarative configuration of the ElasticQuotaStatus type for use
 // with apply.
 type ElasticQuotaStatusApplyConfiguration struct {
-	Used *v1.ResourceList `json:"used,omitempty"`
+	Used            *v1.ResourceList                     `json:"used,omitempty"`
+	Borrowed        *v1.ResourceList                     `json:"borrowed,omitempty"`
+	Lent            *v1.ResourceList                     `json:"lent,omitempty"`
+	PendingRequests *v1.ResourceList                     `json:"pendingRequests,omitempty"`
+	Conditions      []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 }
 
 // ElasticQuotaStatusApplyConfiguration constructs a declarative configuration of the ElasticQuotaStatus type for use with
@@ -41,3 +46,40 @@ func (b *ElasticQuotaStatusApplyConfiguration) WithUsed(value v1.ResourceList) *
 	b.Used = &value
 	return b
 }
+
+// WithBorrowed sets the Borrowed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Borrowed field is set to the value of the last call.
+func (b *ElasticQuotaStatusApplyConfiguration) WithBorrowed(value v1.ResourceList) *ElasticQuotaStatusApplyConfiguration {
+	b.Borrowed = &value
+	return b
+}
+
+// WithLent sets the Lent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Lent field is set to the value of the last call.
+func (b *ElasticQuotaStatusApplyConfiguration) WithLent(value v1.ResourceList) *ElasticQuotaStatusApplyConfiguration {
+	b.Lent = &value
+	return b
+}
+
+// WithPendingRequests sets the PendingRequests field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PendingRequests field is set to the value of the last call.
+func (b *ElasticQuotaStatusApplyConfiguration) WithPendingRequests(value v1.ResourceList) *ElasticQuotaStatusApplyConfiguration {
+	b.PendingRequests = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *ElasticQuotaStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *ElasticQuotaStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
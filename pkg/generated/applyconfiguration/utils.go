@@ -23,8 +23,10 @@ import (
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	testing "k8s.io/client-go/testing"
 	v1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	v1beta1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1beta1"
 	internal "sigs.k8s.io/scheduler-plugins/pkg/generated/applyconfiguration/internal"
 	schedulingv1alpha1 "sigs.k8s.io/scheduler-plugins/pkg/generated/applyconfiguration/scheduling/v1alpha1"
+	schedulingv1beta1 "sigs.k8s.io/scheduler-plugins/pkg/generated/applyconfiguration/scheduling/v1beta1"
 )
 
 // ForKind returns an apply configuration type for the given GroupVersionKind, or nil if no
@@ -45,6 +47,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 	case v1alpha1.SchemeGroupVersion.WithKind("PodGroupStatus"):
 		return &schedulingv1alpha1.PodGroupStatusApplyConfiguration{}
 
+		// Group=scheduling.x-k8s.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithKind("ElasticQuota"):
+		return &schedulingv1beta1.ElasticQuotaApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ElasticQuotaSpec"):
+		return &schedulingv1beta1.ElasticQuotaSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ElasticQuotaStatus"):
+		return &schedulingv1beta1.ElasticQuotaStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PodGroup"):
+		return &schedulingv1beta1.PodGroupApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PodGroupSpec"):
+		return &schedulingv1beta1.PodGroupSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PodGroupStatus"):
+		return &schedulingv1beta1.PodGroupStatusApplyConfiguration{}
+
 	}
 	return nil
 }
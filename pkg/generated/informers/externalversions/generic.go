@@ -24,6 +24,7 @@ import (
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 	v1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	v1beta1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1beta1"
 )
 
 // GenericInformer is type of SharedIndexInformer which will locate and delegate to other
@@ -58,6 +59,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case v1alpha1.SchemeGroupVersion.WithResource("podgroups"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Scheduling().V1alpha1().PodGroups().Informer()}, nil
 
+		// Group=scheduling.x-k8s.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("elasticquotas"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Scheduling().V1beta1().ElasticQuotas().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("podgroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Scheduling().V1beta1().PodGroups().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+
+	"k8s.io/component-base/metrics"
+)
+
+// ElasticQuotaUsage is the accounting of a resource in the ElasticQuota of a namespace.
+// Min and Max are nil when the quota does not bound the resource.
+type ElasticQuotaUsage struct {
+	Namespace string
+	Resource  string
+	Used      float64
+	Min       *float64
+	Max       *float64
+}
+
+var (
+	elasticQuotaUsedDesc = metrics.NewDesc(
+		metrics.BuildFQName("", SchedulerPluginsSubsystem, "elasticquota_used"),
+		"Resources used in an ElasticQuota, as accounted by CapacityScheduling. CPU is in cores, other resources in their base unit.",
+		[]string{"namespace", "resource"}, nil, metrics.ALPHA, "")
+	elasticQuotaMinDesc = metrics.NewDesc(
+		metrics.BuildFQName("", SchedulerPluginsSubsystem, "elasticquota_min"),
+		"Min of an ElasticQuota, as accounted by CapacityScheduling. CPU is in cores, other resources in their base unit.",
+		[]string{"namespace", "resource"}, nil, metrics.ALPHA, "")
+	elasticQuotaMaxDesc = metrics.NewDesc(
+		metrics.BuildFQName("", SchedulerPluginsSubsystem, "elasticquota_max"),
+		"Max of an ElasticQuota, as accounted by CapacityScheduling. CPU is in cores, other resources in their base unit.",
+		[]string{"namespace", "resource"}, nil, metrics.ALPHA, "")
+
+	elasticQuotaCollector = &quotaCollector{}
+)
+
+// SetElasticQuotaSource sets the function listing the ElasticQuota usage on
+// every scrape. Reporting the usage on scrape, rather than maintaining gauges,
+// drops the series of deleted quotas without further bookkeeping.
+func SetElasticQuotaSource(source func() []ElasticQuotaUsage) {
+	elasticQuotaCollector.mu.Lock()
+	defer elasticQuotaCollector.mu.Unlock()
+	elasticQuotaCollector.source = source
+}
+
+type quotaCollector struct {
+	metrics.BaseStableCollector
+
+	mu     sync.RWMutex
+	source func() []ElasticQuotaUsage
+}
+
+var _ metrics.StableCollector = &quotaCollector{}
+
+func (c *quotaCollector) DescribeWithStability(ch chan<- *metrics.Desc) {
+	ch <- elasticQuotaUsedDesc
+	ch <- elasticQuotaMinDesc
+	ch <- elasticQuotaMaxDesc
+}
+
+func (c *quotaCollector) CollectWithStability(ch chan<- metrics.Metric) {
+	c.mu.RLock()
+	source := c.source
+	c.mu.RUnlock()
+	if source == nil {
+		return
+	}
+	for _, u := range source() {
+		ch <- metrics.NewLazyConstMetric(elasticQuotaUsedDesc, metrics.GaugeValue, u.Used, u.Namespace, u.Resource)
+		if u.Min != nil {
+			ch <- metrics.NewLazyConstMetric(elasticQuotaMinDesc, metrics.GaugeValue, *u.Min, u.Namespace, u.Resource)
+		}
+		if u.Max != nil {
+			ch <- metrics.NewLazyConstMetric(elasticQuotaMaxDesc, metrics.GaugeValue, *u.Max, u.Namespace, u.Resource)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/component-base/metrics/testutil"
+	"k8s.io/utils/ptr"
+)
+
+func TestElasticQuotaCollector(t *testing.T) {
+	// A collector can only be registered once, so every comparison uses a new one.
+	if err := testutil.CustomCollectAndCompare(&quotaCollector{}, strings.NewReader("")); err != nil {
+		t.Fatalf("expected no metrics without source: %v", err)
+	}
+
+	c := &quotaCollector{}
+	c.source = func() []ElasticQuotaUsage {
+		return []ElasticQuotaUsage{
+			{Namespace: "ns1", Resource: "cpu", Used: 1.5, Min: ptr.To(2.0), Max: ptr.To(4.0)},
+			{Namespace: "ns1", Resource: "nvidia.com/gpu", Used: 1, Min: ptr.To(0.0)},
+		}
+	}
+	expected := `
+# HELP scheduler_plugins_elasticquota_max [ALPHA] Max of an ElasticQuota, as accounted by CapacityScheduling. CPU is in cores, other resources in their base unit.
+# TYPE scheduler_plugins_elasticquota_max gauge
+scheduler_plugins_elasticquota_max{namespace="ns1",resource="cpu"} 4
+# HELP scheduler_plugins_elasticquota_min [ALPHA] Min of an ElasticQuota, as accounted by CapacityScheduling. CPU is in cores, other resources in their base unit.
+# TYPE scheduler_plugins_elasticquota_min gauge
+scheduler_plugins_elasticquota_min{namespace="ns1",resource="cpu"} 2
+scheduler_plugins_elasticquota_min{namespace="ns1",resource="nvidia.com/gpu"} 0
+# HELP scheduler_plugins_elasticquota_used [ALPHA] Resources used in an ElasticQuota, as accounted by CapacityScheduling. CPU is in cores, other resources in their base unit.
+# TYPE scheduler_plugins_elasticquota_used gauge
+scheduler_plugins_elasticquota_used{namespace="ns1",resource="cpu"} 1.5
+scheduler_plugins_elasticquota_used{namespace="ns1",resource="nvidia.com/gpu"} 1
+`
+	if err := testutil.CustomCollectAndCompare(c, strings.NewReader(expected)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics holds the Prometheus metrics of the scheduler plugins. They are
+// registered with the component-base legacy registry, so the scheduler serves
+// them on its /metrics endpoint alongside the upstream scheduler metrics.
+package metrics
+
+import (
+	"sync"
+
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+)
+
+// SchedulerPluginsSubsystem is the subsystem of all the scheduler plugins metrics.
+const SchedulerPluginsSubsystem = "scheduler_plugins"
+
+// Results of a gang waiting in Permit.
+const (
+	GangResultAllowed  = "allowed"
+	GangResultRejected = "rejected"
+)
+
+// Reasons for which Coscheduling rejects pods.
+const (
+	RejectionReasonPreFilter  = "PreFilter"
+	RejectionReasonPostFilter = "PostFilter"
+	RejectionReasonPermit     = "Permit"
+	RejectionReasonUnreserve  = "Unreserve"
+)
+
+var (
+	CoschedulingGangWaitDuration = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Subsystem: SchedulerPluginsSubsystem,
+			Name:      "coscheduling_gang_wait_duration_seconds",
+			Help:      "Time from the first member of a gang waiting in Permit to the gang being allowed or rejected, by result.",
+			// Start with 10ms with the last bucket being [~164s, Inf)
+			Buckets:        metrics.ExponentialBuckets(0.01, 2, 15),
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"result"})
+
+	CoschedulingRejectionsTotal = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      SchedulerPluginsSubsystem,
+			Name:           "coscheduling_rejections_total",
+			Help:           "Number of pods rejected by Coscheduling, by the extension point rejecting them.",
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"reason"})
+
+	NodeResourceTopologyCacheDirtyNodes = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Subsystem:      SchedulerPluginsSubsystem,
+			Name:           "noderesourcetopology_cache_dirty_nodes",
+			Help:           "Number of nodes whose NodeResourceTopology cache may be over-reserved, as of the last resync.",
+			StabilityLevel: metrics.ALPHA,
+		})
+
+	NodeResourceTopologyCacheForeignNodes = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Subsystem:      SchedulerPluginsSubsystem,
+			Name:           "noderesourcetopology_cache_foreign_nodes",
+			Help:           "Number of nodes running pods not scheduled by this scheduler, as of the last resync.",
+			StabilityLevel: metrics.ALPHA,
+		})
+
+	NodeResourceTopologyCacheResyncDuration = metrics.NewHistogram(
+		&metrics.HistogramOpts{
+			Subsystem:      SchedulerPluginsSubsystem,
+			Name:           "noderesourcetopology_cache_resync_duration_seconds",
+			Help:           "Duration of the NodeResourceTopology cache resyncs which found dirty nodes.",
+			Buckets:        metrics.ExponentialBuckets(0.001, 2, 15),
+			StabilityLevel: metrics.ALPHA,
+		})
+
+	TrimaranCollectorLag = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Subsystem:      SchedulerPluginsSubsystem,
+			Name:           "trimaran_collector_lag_seconds",
+			Help:           "Age of the load watcher metrics when last fetched by the Trimaran collector.",
+			StabilityLevel: metrics.ALPHA,
+		})
+
+	TrimaranNodeScore = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Subsystem:      SchedulerPluginsSubsystem,
+			Name:           "trimaran_node_score",
+			Help:           "Final node scores of the Trimaran plugins, by plugin.",
+			Buckets:        metrics.LinearBuckets(0, 10, 11),
+			StabilityLevel: metrics.ALPHA,
+		}, []string{"plugin"})
+
+	SySchedExposureAverage = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Subsystem:      SchedulerPluginsSubsystem,
+			Name:           "sysched_exposure_average",
+			Help:           "Running average of the extraneous system call exposure scored by SySched.",
+			StabilityLevel: metrics.ALPHA,
+		})
+
+	SySchedExposure = metrics.NewHistogram(
+		&metrics.HistogramOpts{
+			Subsystem:      SchedulerPluginsSubsystem,
+			Name:           "sysched_exposure",
+			Help:           "Extraneous system call exposure of the nodes scored by SySched.",
+			Buckets:        metrics.ExponentialBuckets(1, 2, 15),
+			StabilityLevel: metrics.ALPHA,
+		})
+
+	metricsList = []metrics.Registerable{
+		CoschedulingGangWaitDuration,
+		CoschedulingRejectionsTotal,
+		NodeResourceTopologyCacheDirtyNodes,
+		NodeResourceTopologyCacheForeignNodes,
+		NodeResourceTopologyCacheResyncDuration,
+		TrimaranCollectorLag,
+		TrimaranNodeScore,
+		SySchedExposureAverage,
+		SySchedExposure,
+	}
+)
+
+var registerMetrics sync.Once
+
+// Register all the scheduler plugins metrics. It is safe to call it several
+// times, so every plugin registers the metrics when it is created.
+func Register() {
+	registerMetrics.Do(func() {
+		for _, metric := range metricsList {
+			legacyregistry.MustRegister(metric)
+		}
+		legacyregistry.CustomMustRegister(elasticQuotaCollector)
+	})
+}
+
+// ObserveTrimaranScores records the final node scores of a Trimaran plugin.
+func ObserveTrimaranScores(plugin string, scores framework.NodeScoreList) {
+	observer := TrimaranNodeScore.WithLabelValues(plugin)
+	for _, score := range scores {
+		observer.Observe(float64(score.Score))
+	}
+}
@@ -24,10 +24,10 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/klog/v2"
-	schedulerconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
 	"k8s.io/kubernetes/pkg/scheduler/framework"
 
 	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
 )
 
 // Allocatable is a score plugin that favors nodes based on their allocatable
@@ -47,16 +47,6 @@ func (alloc *Allocatable) Name() string {
 	return AllocatableName
 }
 
-func validateResources(resources []schedulerconfig.ResourceSpec) error {
-	for _, resource := range resources {
-		if resource.Weight <= 0 {
-			return fmt.Errorf("resource Weight of %v should be a positive value, got %v", resource.Name, resource.Weight)
-		}
-		// No upper bound on weight.
-	}
-	return nil
-}
-
 // Score invoked at the score extension point.
 func (alloc *Allocatable) Score(ctx context.Context, state *framework.CycleState, pod *v1.Pod, nodeName string) (int64, *framework.Status) {
 	logger := klog.FromContext(ctx)
@@ -66,7 +56,9 @@ func (alloc *Allocatable) Score(ctx context.Context, state *framework.CycleState
 	}
 
 	// alloc.score favors nodes with least allocatable or most allocatable resources.
-	// It calculates the sum of the node's weighted allocatable resources.
+	// It calculates the sum of the node's weighted allocatable resources, or of the resources
+	// left once the pod is placed. In ratio matching mode, it favors the nodes whose resources
+	// best match the ratio of the resources requested by the pod.
 	//
 	// Note: the returned "score" is negative for least allocatable, and positive for most allocatable.
 	return alloc.score(logger, pod, nodeInfo)
@@ -83,6 +75,8 @@ func NewAllocatable(ctx context.Context, allocArgs runtime.Object, h framework.H
 	// Start with default values.
 	mode := config.Least
 	resToWeightMap := defaultResourcesToWeightMap
+	extendedResourceWeight := defaultExtendedResourceWeight
+	subtractRequested := false
 
 	// Update values from args, if specified.
 	if allocArgs != nil {
@@ -90,41 +84,53 @@ func NewAllocatable(ctx context.Context, allocArgs runtime.Object, h framework.H
 		if !ok {
 			return nil, fmt.Errorf("want args to be of type NodeResourcesAllocatableArgs, got %T", allocArgs)
 		}
+		if err := validation.ValidateNodeResourcesAllocatableArgs(nil, args); err != nil {
+			return nil, err
+		}
 		if args.Mode != "" {
 			mode = args.Mode
-			if mode != config.Least && mode != config.Most {
-				return nil, fmt.Errorf("invalid mode, got %s", mode)
-			}
 		}
 
 		if len(args.Resources) > 0 {
-			if err := validateResources(args.Resources); err != nil {
-				return nil, err
-			}
-
 			resToWeightMap = make(resourceToWeightMap)
 			for _, resource := range args.Resources {
 				resToWeightMap[v1.ResourceName(resource.Name)] = resource.Weight
 			}
 		}
+
+		extendedResourceWeight = args.ExtendedResourceWeight
+		subtractRequested = args.SubtractRequested
 	}
 
 	return &Allocatable{
 		handle: h,
 		resourceAllocationScorer: resourceAllocationScorer{
-			Name:                AllocatableName,
-			scorer:              resourceScorer(logger, resToWeightMap, mode),
-			resourceToWeightMap: resToWeightMap,
+			Name:                   AllocatableName,
+			scorer:                 resourceScorer(logger, mode, subtractRequested),
+			resourceToWeightMap:    resToWeightMap,
+			extendedResourceWeight: extendedResourceWeight,
 		},
 	}, nil
 }
 
-func resourceScorer(logger klog.Logger, resToWeightMap resourceToWeightMap, mode config.ModeType) func(resourceToValueMap, resourceToValueMap) int64 {
-	return func(requested, allocable resourceToValueMap) int64 {
+func resourceScorer(logger klog.Logger, mode config.ModeType, subtractRequested bool) func(resourceToWeightMap, resourceToValueMap, resourceToValueMap, resourceToValueMap) int64 {
+	return func(resToWeightMap resourceToWeightMap, podRequested, requested, allocable resourceToValueMap) int64 {
+		available := allocable
+		if subtractRequested {
+			available = make(resourceToValueMap, len(allocable))
+			for resource := range resToWeightMap {
+				available[resource] = allocable[resource] - requested[resource]
+			}
+		}
+
+		if mode == config.RatioMatching {
+			return ratioScore(resToWeightMap, podRequested, available)
+		}
+
 		// TODO: consider volumes in scoring.
 		var nodeScore, weightSum int64
 		for resource, weight := range resToWeightMap {
-			resourceScore := score(logger, allocable[resource], mode)
+			resourceScore := score(logger, available[resource], mode)
 			nodeScore += resourceScore * weight
 			weightSum += weight
 		}
@@ -132,6 +138,28 @@ func resourceScorer(logger klog.Logger, resToWeightMap resourceToWeightMap, mode
 	}
 }
 
+// ratioScaleFactor scales the similarity of the node and pod resource ratios, in [0, 1], to a score.
+const ratioScaleFactor = 1 << 20
+
+// ratioScore returns how well the ratio of the available resources of a node, e.g. CPU:memory:GPU,
+// matches the ratio of the resources requested by the pod, as the cosine similarity of the weighted
+// resource vectors. Placing pods on nodes with a matching shape leaves less resources stranded,
+// e.g. memory left on a node whose CPUs are all requested.
+func ratioScore(resToWeightMap resourceToWeightMap, podRequested, available resourceToValueMap) int64 {
+	var dot, podNorm, nodeNorm float64
+	for resource, weight := range resToWeightMap {
+		podValue := float64(podRequested[resource]) * float64(weight)
+		nodeValue := float64(max(available[resource], 0)) * float64(weight)
+		dot += podValue * nodeValue
+		podNorm += podValue * podValue
+		nodeNorm += nodeValue * nodeValue
+	}
+	if podNorm == 0 || nodeNorm == 0 {
+		return 0
+	}
+	return int64(dot / (math.Sqrt(podNorm) * math.Sqrt(nodeNorm)) * ratioScaleFactor)
+}
+
 func score(logger klog.Logger, capacity int64, mode config.ModeType) int64 {
 	switch mode {
 	case config.Least:
@@ -36,6 +36,8 @@ import (
 	"sigs.k8s.io/scheduler-plugins/apis/config"
 )
 
+const gpuResourceName v1.ResourceName = "example.com/gpu"
+
 func TestNodeResourcesAllocatable(t *testing.T) {
 	labels1 := map[string]string{
 		"foo": "bar",
@@ -85,6 +87,16 @@ func TestNodeResourcesAllocatable(t *testing.T) {
 		v1.ResourceMemory: resource.MustParse("1Gi")},
 	)
 
+	gpuPod := makePod("gpu", v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("1000m"),
+		v1.ResourceMemory: resource.MustParse("1Gi"),
+		gpuResourceName:   resource.MustParse("1")},
+	)
+	bigCpuScheduled := makePod("bigCpuScheduled", v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("6000m"),
+		v1.ResourceMemory: resource.MustParse("1Gi")},
+	)
+
 	// 1 millicore weighted the same as 1 MiB.
 	defaultResourceAllocatableSet := []schedulerconfig.ResourceSpec{
 		{Name: string(v1.ResourceCPU), Weight: 1 << 20},
@@ -97,8 +109,13 @@ func TestNodeResourcesAllocatable(t *testing.T) {
 		{Name: string(v1.ResourceMemory), Weight: 1},
 	}
 
+	cpuOnlyResourceAllocatableSet := []schedulerconfig.ResourceSpec{
+		{Name: string(v1.ResourceCPU), Weight: 1},
+	}
+
 	modeLeast := config.Least
 	modeMost := config.Most
+	modeRatio := config.RatioMatching
 	tests := []struct {
 		pod          *v1.Pod
 		pods         []*v1.Pod
@@ -216,12 +233,82 @@ func TestNodeResourcesAllocatable(t *testing.T) {
 				{Name: "machine3", Score: framework.MaxNodeScore}},
 			name: "nothing scheduled, resources requested, 3 differently sized machines, most mode",
 		},
+		{
+			pod: gpuPod,
+			nodeInfos: []*framework.NodeInfo{
+				makeExtendedNodeInfo("machine1", 4000, 10*1<<30, 8),
+				makeExtendedNodeInfo("machine2", 4000, 10*1<<30, 1)},
+			args:         config.NodeResourcesAllocatableArgs{Resources: defaultResourceAllocatableSet, Mode: modeLeast},
+			expectedList: []framework.NodeScore{{Name: "machine1", Score: framework.MinNodeScore}, {Name: "machine2", Score: framework.MinNodeScore}},
+			name:         "extended resources requested, ignored without extended resource weight, least mode",
+		},
+		{
+			pod: gpuPod,
+			nodeInfos: []*framework.NodeInfo{
+				makeExtendedNodeInfo("machine1", 4000, 10*1<<30, 8),
+				makeExtendedNodeInfo("machine2", 4000, 10*1<<30, 1)},
+			args:         config.NodeResourcesAllocatableArgs{Resources: defaultResourceAllocatableSet, Mode: modeLeast, ExtendedResourceWeight: 1 << 30},
+			expectedList: []framework.NodeScore{{Name: "machine1", Score: framework.MinNodeScore}, {Name: "machine2", Score: framework.MaxNodeScore}},
+			name:         "extended resources requested, with extended resource weight, least mode",
+		},
+		{
+			pod: cpuAndMemory,
+			nodeInfos: []*framework.NodeInfo{
+				makeExtendedNodeInfo("machine1", 8000, 10*1<<30, 0, bigCpuScheduled),
+				makeExtendedNodeInfo("machine2", 4000, 10*1<<30, 0)},
+			args:         config.NodeResourcesAllocatableArgs{Resources: cpuOnlyResourceAllocatableSet, Mode: modeLeast},
+			expectedList: []framework.NodeScore{{Name: "machine1", Score: framework.MinNodeScore}, {Name: "machine2", Score: framework.MaxNodeScore}},
+			name:         "resources requested, pods scheduled with resources, least mode",
+		},
+		{
+			pod: cpuAndMemory,
+			nodeInfos: []*framework.NodeInfo{
+				makeExtendedNodeInfo("machine1", 8000, 10*1<<30, 0, bigCpuScheduled),
+				makeExtendedNodeInfo("machine2", 4000, 10*1<<30, 0)},
+			args:         config.NodeResourcesAllocatableArgs{Resources: cpuOnlyResourceAllocatableSet, Mode: modeLeast, SubtractRequested: true},
+			expectedList: []framework.NodeScore{{Name: "machine1", Score: framework.MaxNodeScore}, {Name: "machine2", Score: framework.MinNodeScore}},
+			name:         "resources requested, pods scheduled with resources, least mode, requested subtracted",
+		},
+		{
+			pod: cpuAndMemory,
+			nodeInfos: []*framework.NodeInfo{
+				makeNodeInfo("machine1", 4000, 4*1<<30),
+				makeNodeInfo("machine2", 4000, 16*1<<30)},
+			args:         config.NodeResourcesAllocatableArgs{Resources: defaultResourceAllocatableSet, Mode: modeRatio},
+			expectedList: []framework.NodeScore{{Name: "machine1", Score: framework.MaxNodeScore}, {Name: "machine2", Score: framework.MinNodeScore}},
+			name:         "nothing scheduled, resources requested, differently shaped machines, ratio matching mode",
+		},
+		{
+			pod: cpuAndMemory,
+			nodeInfos: []*framework.NodeInfo{
+				makeExtendedNodeInfo("machine1", 8000, 4*1<<30, 0, bigCpuScheduled),
+				makeExtendedNodeInfo("machine2", 4000, 16*1<<30, 0)},
+			args:         config.NodeResourcesAllocatableArgs{Resources: defaultResourceAllocatableSet, Mode: modeRatio, SubtractRequested: true},
+			expectedList: []framework.NodeScore{{Name: "machine1", Score: framework.MaxNodeScore}, {Name: "machine2", Score: framework.MinNodeScore}},
+			name:         "resources requested, pods scheduled with resources, ratio matching mode, requested subtracted",
+		},
+		{
+			pod: gpuPod,
+			nodeInfos: []*framework.NodeInfo{
+				makeExtendedNodeInfo("machine1", 8000, 8*1<<30, 8),
+				makeExtendedNodeInfo("machine2", 8000, 8*1<<30, 1)},
+			args:         config.NodeResourcesAllocatableArgs{Resources: defaultResourceAllocatableSet, Mode: modeRatio, ExtendedResourceWeight: 1 << 30},
+			expectedList: []framework.NodeScore{{Name: "machine1", Score: framework.MaxNodeScore}, {Name: "machine2", Score: framework.MinNodeScore}},
+			name:         "extended resources requested, differently shaped machines, ratio matching mode",
+		},
+		{
+			pod:       cpuAndMemory,
+			nodeInfos: []*framework.NodeInfo{makeNodeInfo("machine", 4000, 10000)},
+			args:      config.NodeResourcesAllocatableArgs{Resources: defaultResourceAllocatableSet, ExtendedResourceWeight: -1},
+			wantErr:   "extendedResourceWeight: Invalid value: -1: weight must not be negative",
+			name:      "extended resources with negative weight",
+		},
 		{
 			// resource with negative weight is not allowed
 			pod:       cpuAndMemory,
 			nodeInfos: []*framework.NodeInfo{makeNodeInfo("machine", 4000, 10000)},
 			args:      config.NodeResourcesAllocatableArgs{Resources: []schedulerconfig.ResourceSpec{{Name: "memory", Weight: -1}, {Name: "cpu", Weight: 1}}},
-			wantErr:   "resource Weight of memory should be a positive value, got -1",
+			wantErr:   "resources[0].weight: Invalid value: -1: resource weight must be positive",
 			name:      "resource with negative weight",
 		},
 		{
@@ -229,7 +316,7 @@ func TestNodeResourcesAllocatable(t *testing.T) {
 			pod:       cpuAndMemory,
 			nodeInfos: []*framework.NodeInfo{makeNodeInfo("machine", 4000, 10000)},
 			args:      config.NodeResourcesAllocatableArgs{Resources: []schedulerconfig.ResourceSpec{{Name: "memory", Weight: 1}, {Name: "cpu", Weight: 0}}},
-			wantErr:   "resource Weight of cpu should be a positive value, got 0",
+			wantErr:   "resources[1].weight: Invalid value: 0: resource weight must be positive",
 			name:      "resource with zero weight",
 		},
 	}
@@ -321,6 +408,23 @@ func makeNodeInfo(node string, milliCPU, memory int64) *framework.NodeInfo {
 	return ni
 }
 
+func makeExtendedNodeInfo(node string, milliCPU, memory, gpus int64, pods ...*v1.Pod) *framework.NodeInfo {
+	ni := framework.NewNodeInfo(pods...)
+	resources := v1.ResourceList{
+		v1.ResourceCPU:    *resource.NewMilliQuantity(milliCPU, resource.DecimalSI),
+		v1.ResourceMemory: *resource.NewQuantity(memory, resource.BinarySI),
+		gpuResourceName:   *resource.NewQuantity(gpus, resource.DecimalSI),
+	}
+	ni.SetNode(&v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: node},
+		Status: v1.NodeStatus{
+			Capacity:    resources,
+			Allocatable: resources,
+		},
+	})
+	return ni
+}
+
 func makePod(name string, requests v1.ResourceList) *v1.Pod {
 	return &v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -21,7 +21,9 @@ package noderesources
 
 import (
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/klog/v2"
+	v1helper "k8s.io/kubernetes/pkg/apis/core/v1/helper"
 	"k8s.io/kubernetes/pkg/scheduler/framework"
 	schedutil "k8s.io/kubernetes/pkg/scheduler/util"
 )
@@ -35,11 +37,20 @@ type resourceToWeightMap map[v1.ResourceName]int64
 // has a weighted score equivalent to 1 MiB.
 var defaultResourcesToWeightMap = resourceToWeightMap{v1.ResourceMemory: 1, v1.ResourceCPU: 1 << 20}
 
+// defaultExtendedResourceWeight is the weight of the extended resources requested by a pod which
+// are not in the resourceToWeight map. With the default weights, a device weighs as much as a core.
+const defaultExtendedResourceWeight int64 = 1 << 30
+
 // resourceAllocationScorer contains information to calculate resource allocation score.
 type resourceAllocationScorer struct {
-	Name                string
-	scorer              func(requested, allocatable resourceToValueMap) int64
+	Name string
+	// scorer is given the weights of the resources to score, the resources requested by the pod,
+	// the resources requested on the node including the pod, and the allocatable resources of the node.
+	scorer              func(resToWeightMap resourceToWeightMap, podRequested, requested, allocatable resourceToValueMap) int64
 	resourceToWeightMap resourceToWeightMap
+	// extendedResourceWeight is the weight of the extended resources requested by the pod
+	// which are not in resourceToWeightMap. Zero ignores them.
+	extendedResourceWeight int64
 }
 
 // resourceToValueMap contains resource name and score.
@@ -57,27 +68,62 @@ func (r *resourceAllocationScorer) score(
 	if r.resourceToWeightMap == nil {
 		return 0, framework.NewStatus(framework.Error, "resources not found")
 	}
-	requested := make(resourceToValueMap, len(r.resourceToWeightMap))
-	allocatable := make(resourceToValueMap, len(r.resourceToWeightMap))
-	for resource := range r.resourceToWeightMap {
-		allocatable[resource], requested[resource] = calculateResourceAllocatableRequest(logger, nodeInfo, pod, resource)
+	resToWeightMap := r.resourcesToScore(pod)
+	podRequested := make(resourceToValueMap, len(resToWeightMap))
+	requested := make(resourceToValueMap, len(resToWeightMap))
+	allocatable := make(resourceToValueMap, len(resToWeightMap))
+	for resource := range resToWeightMap {
+		podRequested[resource] = calculatePodResourceRequest(pod, resource)
+		allocatable[resource], requested[resource] = calculateResourceAllocatableRequest(logger, nodeInfo, podRequested[resource], resource)
 	}
 
-	score := r.scorer(requested, allocatable)
+	score := r.scorer(resToWeightMap, podRequested, requested, allocatable)
 
 	if logger.V(10).Enabled() {
 		logger.Info("Resources and score",
 			"podName", pod.Name, "nodeName", node.Name, "scorer", r.Name,
 			"allocatableResources", allocatable, "requestedResources", requested,
-			"score", score)
+			"podRequestedResources", podRequested, "score", score)
 	}
 
 	return score, nil
 }
 
+// resourcesToScore returns the weights of the resources to score for the pod: the configured ones,
+// and the extended resources requested by the pod if extendedResourceWeight is set.
+func (r *resourceAllocationScorer) resourcesToScore(pod *v1.Pod) resourceToWeightMap {
+	if r.extendedResourceWeight == 0 {
+		return r.resourceToWeightMap
+	}
+	var resToWeightMap resourceToWeightMap
+	addExtended := func(requests v1.ResourceList) {
+		for resource := range requests {
+			if _, ok := r.resourceToWeightMap[resource]; ok || !v1helper.IsExtendedResourceName(resource) {
+				continue
+			}
+			if resToWeightMap == nil {
+				resToWeightMap = make(resourceToWeightMap, len(r.resourceToWeightMap)+1)
+				for name, weight := range r.resourceToWeightMap {
+					resToWeightMap[name] = weight
+				}
+			}
+			resToWeightMap[resource] = r.extendedResourceWeight
+		}
+	}
+	for i := range pod.Spec.InitContainers {
+		addExtended(pod.Spec.InitContainers[i].Resources.Requests)
+	}
+	for i := range pod.Spec.Containers {
+		addExtended(pod.Spec.Containers[i].Resources.Requests)
+	}
+	if resToWeightMap == nil {
+		return r.resourceToWeightMap
+	}
+	return resToWeightMap
+}
+
 // calculateResourceAllocatableRequest returns resources Allocatable and Requested values
-func calculateResourceAllocatableRequest(logger klog.Logger, nodeInfo *framework.NodeInfo, pod *v1.Pod, resource v1.ResourceName) (int64, int64) {
-	podRequest := calculatePodResourceRequest(pod, resource)
+func calculateResourceAllocatableRequest(logger klog.Logger, nodeInfo *framework.NodeInfo, podRequest int64, resource v1.ResourceName) (int64, int64) {
 	switch resource {
 	case v1.ResourceCPU:
 		return nodeInfo.Allocatable.MilliCPU, (nodeInfo.NonZeroRequested.MilliCPU + podRequest)
@@ -102,18 +148,19 @@ func calculateResourceAllocatableRequest(logger klog.Logger, nodeInfo *framework
 // calculatePodResourceRequest returns the total non-zero requests. If Overhead is defined for the pod and the
 // PodOverhead feature is enabled, the Overhead is added to the result.
 // podResourceRequest = max(sum(podSpec.Containers), podSpec.InitContainers) + overHead
+// The CPU requests are in millicores, as the CPU allocatable.
 func calculatePodResourceRequest(pod *v1.Pod, resource v1.ResourceName) int64 {
 	var podRequest int64
 	for i := range pod.Spec.Containers {
 		container := &pod.Spec.Containers[i]
 		qty := schedutil.GetRequestForResource(resource, &container.Resources.Requests, true)
-		podRequest += qty.Value()
+		podRequest += quantityValue(resource, qty)
 	}
 
 	for i := range pod.Spec.InitContainers {
 		initContainer := &pod.Spec.InitContainers[i]
 		qty := schedutil.GetRequestForResource(resource, &initContainer.Resources.Requests, true)
-		if value := qty.Value(); podRequest < value {
+		if value := quantityValue(resource, qty); podRequest < value {
 			podRequest = value
 		}
 	}
@@ -121,9 +168,16 @@ func calculatePodResourceRequest(pod *v1.Pod, resource v1.ResourceName) int64 {
 	// If Overhead is being utilized, add to the total requests for the pod
 	if pod.Spec.Overhead != nil {
 		if quantity, found := pod.Spec.Overhead[resource]; found {
-			podRequest += quantity.Value()
+			podRequest += quantityValue(resource, quantity)
 		}
 	}
 
 	return podRequest
 }
+
+func quantityValue(name v1.ResourceName, quantity resource.Quantity) int64 {
+	if name == v1.ResourceCPU {
+		return quantity.MilliValue()
+	}
+	return quantity.Value()
+}
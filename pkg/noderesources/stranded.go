@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package noderesources
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
+)
+
+// Stranded is a score plugin that favors nodes on which placing the pod leaves the least
+// resources stranded, i.e. left over but unusable by the typical pods because another
+// resource is exhausted, e.g. lots of CPU left but no memory.
+type Stranded struct {
+	handle framework.Handle
+	resourceAllocationScorer
+}
+
+var _ = framework.ScorePlugin(&Stranded{})
+
+// StrandedName is the name of the plugin used in the Registry and configurations.
+const StrandedName = "NodeResourcesStranded"
+
+// podShape is a typical pod shape, with its requests in the units of the node allocatable
+type podShape struct {
+	requests resourceToValueMap
+	weight   int64
+}
+
+// Name returns name of the plugin. It is used in logs, etc.
+func (st *Stranded) Name() string {
+	return StrandedName
+}
+
+// Score invoked at the score extension point.
+func (st *Stranded) Score(ctx context.Context, state *framework.CycleState, pod *v1.Pod, nodeName string) (int64, *framework.Status) {
+	logger := klog.FromContext(ctx)
+	nodeInfo, err := st.handle.SnapshotSharedLister().NodeInfos().Get(nodeName)
+	if err != nil {
+		return 0, framework.NewStatus(framework.Error, fmt.Sprintf("getting node %q from Snapshot: %v", nodeName, err))
+	}
+
+	// st.score estimates the fraction of the node's resources stranded once the pod is placed,
+	// averaged over the pod shapes, and returns MaxNodeScore when nothing is stranded.
+	return st.score(logger, pod, nodeInfo)
+}
+
+// ScoreExtensions of the Score plugin.
+func (st *Stranded) ScoreExtensions() framework.ScoreExtensions {
+	return nil
+}
+
+// NewStranded initializes a new plugin and returns it.
+func NewStranded(ctx context.Context, strandedArgs runtime.Object, h framework.Handle) (framework.Plugin, error) {
+	args, ok := strandedArgs.(*config.NodeResourcesStrandedArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type NodeResourcesStrandedArgs, got %T", strandedArgs)
+	}
+	if err := validation.ValidateNodeResourcesStrandedArgs(nil, args); err != nil {
+		return nil, err
+	}
+
+	resToWeightMap := make(resourceToWeightMap)
+	for _, resource := range args.Resources {
+		resToWeightMap[v1.ResourceName(resource.Name)] = resource.Weight
+	}
+	shapes := make([]podShape, 0, len(args.PodShapes))
+	for _, shape := range args.PodShapes {
+		requests := make(resourceToValueMap)
+		for name, quantity := range shape.Requests {
+			if _, ok := resToWeightMap[name]; ok && !quantity.IsZero() {
+				requests[name] = quantityValue(name, quantity)
+			}
+		}
+		shapes = append(shapes, podShape{requests: requests, weight: shape.Weight})
+	}
+
+	return &Stranded{
+		handle: h,
+		resourceAllocationScorer: resourceAllocationScorer{
+			Name:                StrandedName,
+			scorer:              strandedScorer(shapes),
+			resourceToWeightMap: resToWeightMap,
+		},
+	}, nil
+}
+
+func strandedScorer(shapes []podShape) func(resourceToWeightMap, resourceToValueMap, resourceToValueMap, resourceToValueMap) int64 {
+	return func(resToWeightMap resourceToWeightMap, _, requested, allocatable resourceToValueMap) int64 {
+		free := make(resourceToValueMap, len(resToWeightMap))
+		for resource := range resToWeightMap {
+			free[resource] = max(allocatable[resource]-requested[resource], 0)
+		}
+
+		var stranded float64
+		var shapeWeightSum int64
+		for _, shape := range shapes {
+			if len(shape.requests) == 0 {
+				continue
+			}
+			stranded += float64(shape.weight) * strandedFraction(resToWeightMap, free, allocatable, shape.requests)
+			shapeWeightSum += shape.weight
+		}
+		if shapeWeightSum == 0 {
+			return framework.MaxNodeScore
+		}
+		return int64(math.Round(float64(framework.MaxNodeScore) * (1 - stranded/float64(shapeWeightSum))))
+	}
+}
+
+// strandedFraction returns the weighted fraction of the allocatable resources of a node which
+// is left over once the free resources are filled with as many pods of the shape as possible.
+func strandedFraction(resToWeightMap resourceToWeightMap, free, allocatable, shape resourceToValueMap) float64 {
+	// the number of pods of the shape which fit in the free resources
+	fits := int64(math.MaxInt64)
+	for resource, request := range shape {
+		fits = min(fits, free[resource]/request)
+	}
+
+	var fraction float64
+	var weightSum int64
+	for resource, weight := range resToWeightMap {
+		if allocatable[resource] <= 0 {
+			continue
+		}
+		left := free[resource] - fits*shape[resource]
+		fraction += float64(weight) * float64(left) / float64(allocatable[resource])
+		weightSum += weight
+	}
+	if weightSum == 0 {
+		return 0
+	}
+	return fraction / float64(weightSum)
+}
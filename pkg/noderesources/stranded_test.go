@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package noderesources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+	schedulerconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/defaultbinder"
+	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/queuesort"
+	frameworkruntime "k8s.io/kubernetes/pkg/scheduler/framework/runtime"
+	tf "k8s.io/kubernetes/pkg/scheduler/testing/framework"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+)
+
+func TestNodeResourcesStranded(t *testing.T) {
+	cpuAndMemory := makePod("cpuAndMemory", v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("1000m"),
+		v1.ResourceMemory: resource.MustParse("1Gi")},
+	)
+	scheduled := makePod("scheduled", v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("2000m"),
+		v1.ResourceMemory: resource.MustParse("2Gi")},
+	)
+
+	resources := []schedulerconfig.ResourceSpec{
+		{Name: string(v1.ResourceCPU), Weight: 1},
+		{Name: string(v1.ResourceMemory), Weight: 1},
+	}
+	// 1 CPU for 4 GiB of memory
+	generalPurpose := config.PodShape{
+		Name:     "general-purpose",
+		Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1"), v1.ResourceMemory: resource.MustParse("4Gi")},
+		Weight:   1,
+	}
+	// 1 CPU for 1 GiB of memory
+	computeOptimized := config.PodShape{
+		Name:     "compute-optimized",
+		Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1"), v1.ResourceMemory: resource.MustParse("1Gi")},
+		Weight:   3,
+	}
+
+	tests := []struct {
+		name           string
+		pod            *v1.Pod
+		nodeInfos      []*framework.NodeInfo
+		args           *config.NodeResourcesStrandedArgs
+		expectedScores []int64
+	}{
+		{
+			name: "node whose shape matches the pod shapes strands less",
+			pod:  cpuAndMemory,
+			nodeInfos: []*framework.NodeInfo{
+				// 3 CPUs and 15Gi left: 3 pods fit, 3Gi stranded out of 16Gi
+				makeExtendedNodeInfo("machine1", 4000, 16*1<<30, 0),
+				// 7 CPUs and 7Gi left: 1 pod fits, 6 CPUs and 3Gi stranded out of 8 each
+				makeExtendedNodeInfo("machine2", 8000, 8*1<<30, 0)},
+			args:           &config.NodeResourcesStrandedArgs{Resources: resources, PodShapes: []config.PodShape{generalPurpose}},
+			expectedScores: []int64{91, 44},
+		},
+		{
+			name: "full node strands nothing",
+			pod:  cpuAndMemory,
+			nodeInfos: []*framework.NodeInfo{
+				makeExtendedNodeInfo("machine1", 3000, 3*1<<30, 0, scheduled),
+				// 1 CPU and 1Gi left, the shape does not fit
+				makeExtendedNodeInfo("machine2", 2000, 2*1<<30, 0)},
+			args:           &config.NodeResourcesStrandedArgs{Resources: resources, PodShapes: []config.PodShape{generalPurpose}},
+			expectedScores: []int64{framework.MaxNodeScore, 50},
+		},
+		{
+			name: "weighted pod shapes",
+			pod:  cpuAndMemory,
+			nodeInfos: []*framework.NodeInfo{
+				// general purpose: 3Gi out of 16Gi stranded, compute optimized: 12Gi out of 16Gi stranded
+				makeExtendedNodeInfo("machine1", 4000, 16*1<<30, 0),
+				// general purpose: 6 CPUs and 3Gi out of 8 stranded, compute optimized: nothing stranded
+				makeExtendedNodeInfo("machine2", 8000, 8*1<<30, 0)},
+			args:           &config.NodeResourcesStrandedArgs{Resources: resources, PodShapes: []config.PodShape{generalPurpose, computeOptimized}},
+			expectedScores: []int64{70, 86},
+		},
+		{
+			name: "resources not requested by any shape are stranded",
+			pod:  cpuAndMemory,
+			nodeInfos: []*framework.NodeInfo{
+				makeExtendedNodeInfo("machine1", 4000, 4*1<<30, 0),
+				makeExtendedNodeInfo("machine2", 4000, 4*1<<30, 2)},
+			args: &config.NodeResourcesStrandedArgs{
+				Resources: append([]schedulerconfig.ResourceSpec{{Name: string(gpuResourceName), Weight: 2}}, resources...),
+				PodShapes: []config.PodShape{computeOptimized},
+			},
+			expectedScores: []int64{framework.MaxNodeScore, 50},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			fh := newStrandedFramework(ctx, t, test.nodeInfos)
+			pl, err := NewStranded(ctx, test.args, fh)
+			if err != nil {
+				t.Fatalf("failed to initialize plugin NodeResourcesStranded, got error: %v", err)
+			}
+
+			plugin := pl.(framework.ScorePlugin)
+			for i, nodeInfo := range test.nodeInfos {
+				score, status := plugin.Score(ctx, nil, test.pod, nodeInfo.Node().Name)
+				if !status.IsSuccess() {
+					t.Fatalf("unexpected error: %v", status)
+				}
+				if score != test.expectedScores[i] {
+					t.Errorf("node %s: expected score %d, got %d", nodeInfo.Node().Name, test.expectedScores[i], score)
+				}
+			}
+		})
+	}
+}
+
+func TestNewStranded(t *testing.T) {
+	resources := []schedulerconfig.ResourceSpec{{Name: string(v1.ResourceCPU), Weight: 1}}
+	tests := []struct {
+		name    string
+		args    runtime.Object
+		wantErr string
+	}{
+		{
+			name: "valid args",
+			args: &config.NodeResourcesStrandedArgs{
+				Resources: resources,
+				PodShapes: []config.PodShape{{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")}, Weight: 1}},
+			},
+		},
+		{
+			name:    "wrong args type",
+			args:    &config.NodeResourcesAllocatableArgs{},
+			wantErr: "want args to be of type NodeResourcesStrandedArgs",
+		},
+		{
+			name:    "no pod shape",
+			args:    &config.NodeResourcesStrandedArgs{Resources: resources},
+			wantErr: "podShapes: Required value",
+		},
+		{
+			name: "pod shape without scored resource",
+			args: &config.NodeResourcesStrandedArgs{
+				Resources: resources,
+				PodShapes: []config.PodShape{{Requests: v1.ResourceList{v1.ResourceMemory: resource.MustParse("1Gi")}, Weight: 1}},
+			},
+			wantErr: "podShapes[0].requests: Invalid value",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			_, err := NewStranded(ctx, test.args, newStrandedFramework(ctx, t, nil))
+			if test.wantErr == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if test.wantErr != "" && (err == nil || !strings.Contains(err.Error(), test.wantErr)) {
+				t.Errorf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func newStrandedFramework(ctx context.Context, t *testing.T, nodeInfos []*framework.NodeInfo) framework.Handle {
+	cs := clientsetfake.NewSimpleClientset()
+	registeredPlugins := []tf.RegisterPluginFunc{
+		tf.RegisterBindPlugin(defaultbinder.Name, defaultbinder.New),
+		tf.RegisterQueueSortPlugin(queuesort.Name, queuesort.New),
+	}
+	fh, err := tf.NewFramework(
+		ctx,
+		registeredPlugins,
+		"default-scheduler",
+		frameworkruntime.WithClientSet(cs),
+		frameworkruntime.WithInformerFactory(informers.NewSharedInformerFactory(cs, 0)),
+		frameworkruntime.WithSnapshotSharedLister(&fakeSharedLister{nodes: nodeInfos}),
+	)
+	if err != nil {
+		t.Fatalf("fail to create framework: %s", err)
+	}
+	return fh
+}
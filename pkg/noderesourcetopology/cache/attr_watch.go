@@ -32,9 +32,12 @@ import (
 )
 
 type Watcher struct {
-	lh    logr.Logger
-	nrts  *nrtStore
+	lh   logr.Logger
+	nrts *nrtStore
+	// nodes tracks the nodes whose attributes changed. If nil, attribute changes are not tracked.
 	nodes counter
+	// onModified, if set, is called with every modified NRT object after the attribute changes are tracked.
+	onModified func(nrt *topologyv1alpha2.NodeResourceTopology)
 }
 
 func (wt Watcher) NodeResourceTopologies(ctx context.Context, client ctrlclient.WithWatch) {
@@ -82,13 +85,16 @@ func (wt Watcher) ProcessEvent(ev watch.Event) bool {
 		return false
 	}
 
-	if !areAttrsChanged(nrtCur, nrtObj) {
-		return false
+	attrsChanged := wt.nodes != nil && areAttrsChanged(nrtCur, nrtObj)
+	if attrsChanged {
+		wt.lh.V(4).Info("attribute change", logging.KeyNode, nrtObj.Name)
+		wt.nodes.Incr(nrtObj.Name)
 	}
 
-	wt.lh.V(4).Info("attribute change", logging.KeyNode, nrtObj.Name)
-	wt.nodes.Incr(nrtObj.Name)
-	return true
+	if wt.onModified != nil {
+		wt.onModified(nrtObj)
+	}
+	return attrsChanged
 }
 
 func areAttrsChanged(oldNrt, newNrt *topologyv1alpha2.NodeResourceTopology) bool {
@@ -151,3 +151,63 @@ func TestWatcherProcessEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestWatcherProcessEventOnModified(t *testing.T) {
+	nrts := []topologyv1alpha2.NodeResourceTopology{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "node-0",
+			},
+			Attributes: []topologyv1alpha2.AttributeInfo{
+				{
+					Name:  "topologyManagerPolicy",
+					Value: "restricted",
+				},
+			},
+		},
+	}
+
+	var modified []string
+	// attribute changes not tracked
+	wt := Watcher{
+		lh:   klog.Background(),
+		nrts: newNrtStore(klog.Background(), nrts),
+		onModified: func(nrt *topologyv1alpha2.NodeResourceTopology) {
+			modified = append(modified, nrt.Name)
+		},
+	}
+
+	events := []watch.Event{
+		{
+			Type: watch.Added,
+			Object: &topologyv1alpha2.NodeResourceTopology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+				},
+			},
+		},
+		{
+			Type: watch.Modified,
+			Object: &topologyv1alpha2.NodeResourceTopology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-0",
+				},
+				Attributes: []topologyv1alpha2.AttributeInfo{
+					{
+						Name:  "topologyManagerPolicy",
+						Value: "single-numa-node",
+					},
+				},
+			},
+		},
+	}
+
+	for _, ev := range events {
+		if wt.ProcessEvent(ev) {
+			t.Errorf("unexpected attribute change tracked for %v", ev.Type)
+		}
+	}
+	if expected := []string{"node-0"}; !reflect.DeepEqual(modified, expected) {
+		t.Errorf("got=%+v expected=%+v", modified, expected)
+	}
+}
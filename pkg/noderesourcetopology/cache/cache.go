@@ -42,7 +42,8 @@ type Interface interface {
 	// GetCachedNRTCopy retrieves a NRT copy from cache, and then deducts over-reserved resources if necessary.
 	// It will be used as the source of truth across the Pod's scheduling cycle.
 	// Over-reserved resources are the resources consumed by pods scheduled to that node after the last update
-	// of NRT pertaining to the same node, pessimistically overallocated on ALL the NUMA zones of the node.
+	// of NRT pertaining to the same node, overallocated only on the NUMA zones the pods are expected to be placed on,
+	// if known, and otherwise pessimistically overallocated on ALL the NUMA zones of the node.
 	// The pod argument is used only for logging purposes.
 	// Returns nil if there is no NRT data available for the node named `nodeName`.
 	// Returns a CachedNRTInfo describing the NRT data returned. Meaningful only if `nrt` != nil.
@@ -68,7 +69,9 @@ type Interface interface {
 	// Additionally, this function resets the discarded counter for the same node. Being able to handle a pod means
 	// that this node has still available resources. If a node was previously discarded and then cleared, we interpret
 	// this sequence of events as the previous pod required too much - a possible and benign condition.
-	ReserveNodeResources(nodeName string, pod *corev1.Pod)
+	// If the zones on which the pod is expected to be placed are known, they can be given in `zones`, so
+	// the cache can account the pod resources only on these zones instead of pessimistically on all of them.
+	ReserveNodeResources(nodeName string, pod *corev1.Pod, zones ...string)
 
 	// UnreserveNodeResources decrement from the node assumed resources the resources required by the given pod.
 	UnreserveNodeResources(nodeName string, pod *corev1.Pod)
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	topologyv1alpha2 "github.com/k8stopologyawareschedwg/noderesourcetopology-api/pkg/apis/topology/v1alpha2"
@@ -29,12 +30,15 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	podlisterv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
 
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	apiconfig "sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/pkg/debug"
+	"sigs.k8s.io/scheduler-plugins/pkg/metrics"
 	"sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology/logging"
 	"sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology/podprovider"
 	"sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology/resourcerequests"
@@ -53,13 +57,14 @@ type OverReserve struct {
 	nodesMaybeOverreserved counter
 	nodesWithForeignPods   counter
 	nodesWithAttrUpdate    counter
-	podLister              podlisterv1.PodLister
+	podLister              podprovider.PodLister
 	resyncMethod           apiconfig.CacheResyncMethod
 	resyncScope            apiconfig.CacheResyncScope
+	resyncTrigger          apiconfig.CacheResyncTrigger
 	isPodRelevant          podprovider.PodFilterFunc
 }
 
-func NewOverReserve(ctx context.Context, lh logr.Logger, cfg *apiconfig.NodeResourceTopologyCache, client ctrlclient.WithWatch, podLister podlisterv1.PodLister, isPodRelevant podprovider.PodFilterFunc) (*OverReserve, error) {
+func NewOverReserve(ctx context.Context, lh logr.Logger, cfg *apiconfig.NodeResourceTopologyCache, client ctrlclient.WithWatch, podLister podprovider.PodLister, isPodRelevant podprovider.PodFilterFunc) (*OverReserve, error) {
 	if client == nil || podLister == nil {
 		return nil, fmt.Errorf("received nil references")
 	}
@@ -71,8 +76,9 @@ func NewOverReserve(ctx context.Context, lh logr.Logger, cfg *apiconfig.NodeReso
 
 	resyncMethod := getCacheResyncMethod(lh, cfg)
 	resyncScope := getCacheResyncScope(lh, cfg)
+	resyncTrigger := getCacheResyncTrigger(lh, cfg)
 
-	lh.V(2).Info("initializing", "noderesourcetopologies", len(nrtObjs.Items), "method", resyncMethod, "scope", resyncScope)
+	lh.V(2).Info("initializing", "noderesourcetopologies", len(nrtObjs.Items), "method", resyncMethod, "scope", resyncScope, "trigger", resyncTrigger)
 	obj := &OverReserve{
 		lh:                     lh,
 		client:                 client,
@@ -83,14 +89,20 @@ func NewOverReserve(ctx context.Context, lh logr.Logger, cfg *apiconfig.NodeReso
 		nodesWithAttrUpdate:    newCounter(),
 		podLister:              podLister,
 		resyncMethod:           resyncMethod,
+		resyncTrigger:          resyncTrigger,
 		isPodRelevant:          isPodRelevant,
 	}
 
-	if resyncScope == apiconfig.CacheResyncScopeAll {
+	if resyncScope == apiconfig.CacheResyncScopeAll || resyncTrigger == apiconfig.CacheResyncTriggerEvent {
 		wt := Watcher{
-			lh:    obj.lh,
-			nrts:  obj.nrts,
-			nodes: obj.nodesWithAttrUpdate,
+			lh:   obj.lh,
+			nrts: obj.nrts,
+		}
+		if resyncScope == apiconfig.CacheResyncScopeAll {
+			wt.nodes = obj.nodesWithAttrUpdate
+		}
+		if resyncTrigger == apiconfig.CacheResyncTriggerEvent {
+			wt.onModified = obj.ResyncNode
 		}
 		go wt.NodeResourceTopologies(ctx, client)
 	}
@@ -146,7 +158,7 @@ func (ov *OverReserve) NodeHasForeignPods(nodeName string, pod *corev1.Pod) {
 	lh.V(2).Info("marked with foreign pods", logging.KeyNode, nodeName, "count", val)
 }
 
-func (ov *OverReserve) ReserveNodeResources(nodeName string, pod *corev1.Pod) {
+func (ov *OverReserve) ReserveNodeResources(nodeName string, pod *corev1.Pod, zones ...string) {
 	lh := ov.lh.WithValues(logging.KeyPod, klog.KObj(pod), logging.KeyPodUID, logging.PodUID(pod), logging.KeyNode, nodeName)
 	ov.lock.Lock()
 	defer ov.lock.Unlock()
@@ -156,7 +168,7 @@ func (ov *OverReserve) ReserveNodeResources(nodeName string, pod *corev1.Pod) {
 		ov.assumedResources[nodeName] = nodeAssumedResources
 	}
 
-	nodeAssumedResources.AddPod(pod)
+	nodeAssumedResources.AddPod(pod, zones...)
 	lh.V(2).Info("post reserve", logging.KeyNode, nodeName, "assumedResources", nodeAssumedResources.String())
 
 	ov.nodesMaybeOverreserved.Delete(nodeName)
@@ -234,6 +246,9 @@ func (ov *OverReserve) GetDesyncedNodes(lh logr.Logger) DesyncedNodes {
 	configChangeNodes := ov.nodesWithAttrUpdate.Clone()
 	configChangeCount := configChangeNodes.Len()
 
+	metrics.NodeResourceTopologyCacheForeignNodes.Set(float64(foreignCount))
+	metrics.NodeResourceTopologyCacheDirtyNodes.Set(float64(overreservedCount))
+
 	if nodes.Len() > 0 {
 		lh.V(4).Info("found dirty nodes", "foreign", foreignCount, "discarded", overreservedCount, "configChange", configChangeCount, "total", nodes.Len())
 	}
@@ -267,6 +282,10 @@ func (ov *OverReserve) Resync() {
 		lh_.V(5).Info("no dirty nodes detected")
 		return
 	}
+	start := time.Now()
+	defer func() {
+		metrics.NodeResourceTopologyCacheResyncDuration.Observe(time.Since(start).Seconds())
+	}()
 
 	// node -> pod identifier (namespace, name)
 	nodeToObjsMap, err := makeNodeToPodDataMap(lh_, ov.podLister, ov.isPodRelevant)
@@ -289,30 +308,7 @@ func (ov *OverReserve) Resync() {
 			continue
 		}
 
-		objs, ok := nodeToObjsMap[nodeName]
-		if !ok {
-			// this really should never happen
-			lh.Info("cannot find any pod for node")
-			continue
-		}
-
-		pfpExpected, onlyExclRes := podFingerprintForNodeTopology(nrtCandidate, ov.resyncMethod)
-		if pfpExpected == "" {
-			lh.V(2).Info("missing NodeTopology podset fingerprint data")
-			continue
-		}
-
-		lh.V(4).Info("trying to sync NodeTopology", "fingerprint", pfpExpected, "onlyExclusiveResources", onlyExclRes)
-
-		err = checkPodFingerprintForNode(lh, objs, nodeName, pfpExpected, onlyExclRes)
-		if errors.Is(err, podfingerprint.ErrSignatureMismatch) {
-			// can happen, not critical
-			lh.V(4).Info("NodeTopology podset fingerprint mismatch")
-			continue
-		}
-		if err != nil {
-			// should never happen, let's be vocal
-			lh.Error(err, "checking NodeTopology podset fingerprint")
+		if !ov.isNodeInSync(lh, nrtCandidate, nodeToObjsMap[nodeName]) {
 			continue
 		}
 
@@ -340,11 +336,105 @@ func (ov *OverReserve) Resync() {
 	ov.FlushNodes(lh_, nrtUpdates...)
 }
 
+// ResyncNode is the event-driven counterpart of Resync. It is meant to be called with the NRT data
+// of a node as soon as it is observed, and it flushes the node if it is dirty and the data matches
+// the pods running on the node, or if the node configuration changed. Clean nodes are left untouched.
+func (ov *OverReserve) ResyncNode(nrtCandidate *topologyv1alpha2.NodeResourceTopology) {
+	nodeName := nrtCandidate.Name
+	lh := ov.lh.WithName(logging.FlowCacheSync).WithValues(logging.KeyNode, nodeName)
+	lh.V(4).Info(logging.FlowBegin)
+	defer lh.V(4).Info(logging.FlowEnd)
+
+	ov.lock.Lock()
+	dirty := ov.isNodeDirty(nodeName)
+	configChanged := ov.nodesWithAttrUpdate.IsSet(nodeName)
+	lh = lh.WithValues(logging.KeyGeneration, ov.generation)
+	ov.lock.Unlock()
+
+	if configChanged {
+		lh.V(4).Info("overriding cached info", "reason", "configChanged")
+		ov.flushNodeIf(lh, nrtCandidate, func() bool { return ov.nodesWithAttrUpdate.IsSet(nodeName) })
+		return
+	}
+
+	if !dirty {
+		lh.V(5).Info("node not dirty")
+		return
+	}
+
+	objs, err := makePodDataForNode(lh, ov.podLister, nodeName, ov.isPodRelevant)
+	if err != nil {
+		lh.Error(err, "cannot find the pods running on node")
+		return
+	}
+
+	if !ov.isNodeInSync(lh, nrtCandidate, objs) {
+		return
+	}
+
+	lh.V(4).Info("overriding cached info", "reason", "resynced")
+	ov.flushNodeIf(lh, nrtCandidate, func() bool { return ov.isNodeDirty(nodeName) })
+}
+
+// isNodeDirty returns true if the cached information about a node may be stale. Must be called
+// with ov.lock held.
+func (ov *OverReserve) isNodeDirty(nodeName string) bool {
+	return ov.nodesMaybeOverreserved.IsSet(nodeName) || ov.nodesWithForeignPods.IsSet(nodeName)
+}
+
+// flushNodeIf flushes the node of the given NRT only if stillNeeded returns true, checked with ov.lock
+// held: a concurrent resync may have flushed the node since its state was last checked.
+func (ov *OverReserve) flushNodeIf(lh logr.Logger, nrt *topologyv1alpha2.NodeResourceTopology, stillNeeded func() bool) {
+	ov.lock.Lock()
+	defer ov.lock.Unlock()
+	if !stillNeeded() {
+		lh.V(4).Info("node resynced meanwhile, not flushing")
+		return
+	}
+	ov.flushNodesLocked(lh, nrt)
+}
+
+// isNodeInSync returns true if the podset fingerprint reported in the NRT data of a node matches
+// the pods running on the node, as found in objs.
+func (ov *OverReserve) isNodeInSync(lh logr.Logger, nrtCandidate *topologyv1alpha2.NodeResourceTopology, objs []podData) bool {
+	nodeName := nrtCandidate.Name
+	if len(objs) == 0 {
+		// this really should never happen
+		lh.Info("cannot find any pod for node")
+		return false
+	}
+
+	pfpExpected, onlyExclRes := podFingerprintForNodeTopology(nrtCandidate, ov.resyncMethod)
+	if pfpExpected == "" {
+		lh.V(2).Info("missing NodeTopology podset fingerprint data")
+		return false
+	}
+
+	lh.V(4).Info("trying to sync NodeTopology", "fingerprint", pfpExpected, "onlyExclusiveResources", onlyExclRes)
+
+	err := checkPodFingerprintForNode(lh, objs, nodeName, pfpExpected, onlyExclRes)
+	if errors.Is(err, podfingerprint.ErrSignatureMismatch) {
+		// can happen, not critical
+		lh.V(4).Info("NodeTopology podset fingerprint mismatch")
+		return false
+	}
+	if err != nil {
+		// should never happen, let's be vocal
+		lh.Error(err, "checking NodeTopology podset fingerprint")
+		return false
+	}
+	return true
+}
+
 // FlushNodes drops all the cached information about a given node, resetting its state clean.
 func (ov *OverReserve) FlushNodes(lh logr.Logger, nrts ...*topologyv1alpha2.NodeResourceTopology) {
 	ov.lock.Lock()
 	defer ov.lock.Unlock()
+	ov.flushNodesLocked(lh, nrts...)
+}
 
+// flushNodesLocked is FlushNodes with ov.lock already held.
+func (ov *OverReserve) flushNodesLocked(lh logr.Logger, nrts ...*topologyv1alpha2.NodeResourceTopology) {
 	for _, nrt := range nrts {
 		lh.V(2).Info("flushing", logging.KeyNode, nrt.Name)
 		ov.nrts.Update(nrt)
@@ -368,6 +458,44 @@ func (ov *OverReserve) Store() *nrtStore {
 	return ov.nrts
 }
 
+// DumpState returns copies of the cached NRTs, sorted by node name, and of the resources assumed
+// on each node since its last resync.
+func (ov *OverReserve) DumpState() *debug.NodeResourceTopologyState {
+	ov.lock.Lock()
+	defer ov.lock.Unlock()
+	state := &debug.NodeResourceTopologyState{
+		Generation:       ov.generation,
+		AssumedResources: make(map[string]map[string]corev1.ResourceList, len(ov.assumedResources)),
+	}
+	for _, nodeName := range sets.List(sets.KeySet(ov.nrts.data)) {
+		state.NodeResourceTopologies = append(state.NodeResourceTopologies, *ov.nrts.data[nodeName].DeepCopy())
+	}
+	for nodeName, resStore := range ov.assumedResources {
+		if len(resStore.data) == 0 {
+			continue
+		}
+		resources := make(map[string]corev1.ResourceList, len(resStore.data))
+		for key, res := range resStore.data {
+			resources[key] = res.DeepCopy()
+		}
+		state.AssumedResources[nodeName] = resources
+	}
+	return state
+}
+
+// DumpDesyncState returns the nodes GetDesyncedNodes reports, keeping apart the ones running foreign pods
+// from the ones which may be overreserved. Unlike GetDesyncedNodes, it does not update any metric.
+func (ov *OverReserve) DumpDesyncState() *debug.NodeResourceTopologyDesyncState {
+	ov.lock.Lock()
+	defer ov.lock.Unlock()
+	return &debug.NodeResourceTopologyDesyncState{
+		Generation:        ov.generation,
+		MaybeOverReserved: sets.List(sets.New(ov.nodesMaybeOverreserved.Keys()...)),
+		ForeignPods:       sets.List(sets.New(ov.nodesWithForeignPods.Keys()...)),
+		ConfigChanged:     sets.List(sets.New(ov.nodesWithAttrUpdate.Keys()...)),
+	}
+}
+
 func makeNodeToPodDataMap(lh logr.Logger, podLister podlisterv1.PodLister, isPodRelevant podprovider.PodFilterFunc) (map[string][]podData, error) {
 	nodeToObjsMap := make(map[string][]podData)
 	pods, err := podLister.List(labels.Everything())
@@ -378,17 +506,35 @@ func makeNodeToPodDataMap(lh logr.Logger, podLister podlisterv1.PodLister, isPod
 		if !isPodRelevant(lh, pod) {
 			continue
 		}
-		nodeObjs := nodeToObjsMap[pod.Spec.NodeName]
-		nodeObjs = append(nodeObjs, podData{
-			Namespace:             pod.Namespace,
-			Name:                  pod.Name,
-			HasExclusiveResources: resourcerequests.AreExclusiveForPod(pod),
-		})
-		nodeToObjsMap[pod.Spec.NodeName] = nodeObjs
+		nodeToObjsMap[pod.Spec.NodeName] = append(nodeToObjsMap[pod.Spec.NodeName], makePodData(pod))
 	}
 	return nodeToObjsMap, nil
 }
 
+// makePodDataForNode is makeNodeToPodDataMap for a single node, listing only the pods bound to it.
+func makePodDataForNode(lh logr.Logger, podLister podprovider.PodLister, nodeName string, isPodRelevant podprovider.PodFilterFunc) ([]podData, error) {
+	pods, err := podLister.ListByNode(nodeName)
+	if err != nil {
+		return nil, err
+	}
+	var objs []podData
+	for _, pod := range pods {
+		if !isPodRelevant(lh, pod) {
+			continue
+		}
+		objs = append(objs, makePodData(pod))
+	}
+	return objs, nil
+}
+
+func makePodData(pod *corev1.Pod) podData {
+	return podData{
+		Namespace:             pod.Namespace,
+		Name:                  pod.Name,
+		HasExclusiveResources: resourcerequests.AreExclusiveForPod(pod),
+	}
+}
+
 func getCacheResyncMethod(lh logr.Logger, cfg *apiconfig.NodeResourceTopologyCache) apiconfig.CacheResyncMethod {
 	var resyncMethod apiconfig.CacheResyncMethod
 	if cfg != nil && cfg.ResyncMethod != nil {
@@ -411,4 +557,15 @@ func getCacheResyncScope(lh logr.Logger, cfg *apiconfig.NodeResourceTopologyCach
 	return resyncScope
 }
 
+func getCacheResyncTrigger(lh logr.Logger, cfg *apiconfig.NodeResourceTopologyCache) apiconfig.CacheResyncTrigger {
+	var resyncTrigger apiconfig.CacheResyncTrigger
+	if cfg != nil && cfg.ResyncTrigger != nil {
+		resyncTrigger = *cfg.ResyncTrigger
+	} else { // explicitly set to nil?
+		resyncTrigger = apiconfig.CacheResyncTriggerPeriodic
+		lh.Info("cache resync trigger missing", "fallback", resyncTrigger)
+	}
+	return resyncTrigger
+}
+
 func (ov *OverReserve) PostBind(nodeName string, pod *corev1.Pod) {}
@@ -30,7 +30,6 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	podlisterv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
 
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -228,7 +227,7 @@ func TestOverreserveGetCachedNRTCopy(t *testing.T) {
 
 	checkGetCachedNRTCopy(
 		t,
-		func(client ctrlclient.WithWatch, podLister podlisterv1.PodLister) (Interface, error) {
+		func(client ctrlclient.WithWatch, podLister podprovider.PodLister) (Interface, error) {
 			return NewOverReserve(context.Background(), klog.Background(), nil, client, podLister, podprovider.IsPodRelevantAlways)
 		},
 		testCases...,
@@ -624,6 +623,155 @@ func TestResyncMatchFingerprint(t *testing.T) {
 	}
 }
 
+func TestResyncNode(t *testing.T) {
+	testPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod1",
+			Namespace: "namespace1",
+		},
+		Spec: corev1.PodSpec{
+			NodeName: "node1",
+			Containers: []corev1.Container{
+				{
+					Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("8"),
+							corev1.ResourceMemory: resource.MustParse("16Gi"),
+						},
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("8"),
+							corev1.ResourceMemory: resource.MustParse("16Gi"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	makeUpdatedNodeTopology := func(pfp string) *topologyv1alpha2.NodeResourceTopology {
+		return &topologyv1alpha2.NodeResourceTopology{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "node1",
+			},
+			Attributes: topologyv1alpha2.AttributeList{
+				{
+					Name:  podfingerprint.Attribute,
+					Value: pfp,
+				},
+			},
+			TopologyPolicies: []string{string(topologyv1alpha2.SingleNUMANodeContainerLevel)},
+			Zones: topologyv1alpha2.ZoneList{
+				{
+					Name: "node-0",
+					Type: "Node",
+					Resources: topologyv1alpha2.ResourceInfoList{
+						MakeTopologyResInfo(cpu, "32", "30"),
+						MakeTopologyResInfo(memory, "64Gi", "60Gi"),
+						MakeTopologyResInfo(nicResourceName, "16", "16"),
+					},
+				},
+				{
+					Name: "node-1",
+					Type: "Node",
+					Resources: topologyv1alpha2.ResourceInfoList{
+						MakeTopologyResInfo(cpu, "32", "22"),
+						MakeTopologyResInfo(memory, "64Gi", "44Gi"),
+						MakeTopologyResInfo(nicResourceName, "16", "16"),
+					},
+				},
+			},
+		}
+	}
+
+	tcases := []struct {
+		description string
+		dirty       bool
+		fingerprint string
+		// flushedMeanwhile makes a concurrent resync flush the node while its pods are listed.
+		flushedMeanwhile bool
+		expectFlushed    bool
+	}{
+		{
+			description:   "dirty node, matching fingerprint",
+			dirty:         true,
+			fingerprint:   "pfp0v0019e0420efb37746c6",
+			expectFlushed: true,
+		},
+		{
+			description:      "dirty node flushed meanwhile, matching fingerprint",
+			dirty:            true,
+			fingerprint:      "pfp0v0019e0420efb37746c6",
+			flushedMeanwhile: true,
+		},
+		{
+			description: "dirty node, mismatching fingerprint",
+			dirty:       true,
+			fingerprint: "pfp0v0010000000000000000",
+		},
+		{
+			description: "clean node",
+			fingerprint: "pfp0v0019e0420efb37746c6",
+		},
+	}
+
+	for _, tc := range tcases {
+		t.Run(tc.description, func(t *testing.T) {
+			// the NRT updates are never created in the fake client: the resync must not need to fetch them
+			fakeClient, err := tu.NewFakeClient()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fakePodLister := &fakePodLister{}
+
+			nrtCache := mustOverReserve(t, fakeClient, fakePodLister)
+
+			nodeTopologies := makeDefaultTestTopology()
+			for _, obj := range nodeTopologies {
+				nrtCache.Store().Update(obj)
+			}
+
+			nrtCache.ReserveNodeResources("node1", testPod)
+			if tc.dirty {
+				nrtCache.NodeMaybeOverReserved("node1", testPod)
+			}
+
+			runningPod := testPod.DeepCopy()
+			runningPod.Status.Phase = corev1.PodRunning
+			fakePodLister.AddPod(runningPod)
+			// pods on other nodes would change the fingerprint if they were accounted
+			otherPod := runningPod.DeepCopy()
+			otherPod.Name = "pod2"
+			otherPod.Spec.NodeName = "node2"
+			fakePodLister.AddPod(otherPod)
+
+			if tc.flushedMeanwhile {
+				fakePodLister.onListByNode = func(nodeName string) {
+					nrtCache.FlushNodes(klog.Background(), nodeTopologies[0])
+				}
+			}
+
+			updatedNodeTopology := makeUpdatedNodeTopology(tc.fingerprint)
+			nrtCache.ResyncNode(updatedNodeTopology)
+
+			if fakePodLister.listCalls != 0 {
+				t.Errorf("unexpected listing of all the pods, %d times", fakePodLister.listCalls)
+			}
+
+			expectDirty := tc.dirty && !tc.expectFlushed && !tc.flushedMeanwhile
+			dirtyNodes := nrtCache.GetDesyncedNodes(klog.Background())
+			if (dirtyNodes.Len() > 0) != expectDirty {
+				t.Errorf("unexpected dirty nodes: %v, expected dirty: %v", dirtyNodes, expectDirty)
+			}
+
+			nrtObj, _ := nrtCache.GetCachedNRTCopy(context.Background(), "node1", testPod)
+			if got := isNRTEqual(nrtObj, updatedNodeTopology); got != tc.expectFlushed {
+				t.Errorf("unexpected nrt from cache, flushed=%v expected=%v\ngot: %v\n", got, tc.expectFlushed, dumpNRT(nrtObj))
+			}
+		})
+	}
+}
+
 func isNRTEqual(a, b *topologyv1alpha2.NodeResourceTopology) bool {
 	return equality.Semantic.DeepDerivative(a.Zones, b.Zones) &&
 		equality.Semantic.DeepDerivative(a.TopologyPolicies, b.TopologyPolicies) &&
@@ -720,13 +868,19 @@ func TestNodeWithForeignPods(t *testing.T) {
 		t.Errorf("unexpected dirty nodes: %v", nodes.MaybeOverReserved)
 	}
 
+	nrtCache.NodeMaybeOverReserved("node1", &corev1.Pod{})
+	desync := nrtCache.DumpDesyncState()
+	if !reflect.DeepEqual(desync.MaybeOverReserved, []string{"node1"}) || !reflect.DeepEqual(desync.ForeignPods, []string{target}) {
+		t.Errorf("unexpected desync state: %+v", desync)
+	}
+
 	_, info := nrtCache.GetCachedNRTCopy(context.Background(), target, &corev1.Pod{})
 	if info.Fresh {
 		t.Errorf("succesfully got node with foreign pods!")
 	}
 }
 
-func mustOverReserve(t *testing.T, client ctrlclient.WithWatch, podLister podlisterv1.PodLister) *OverReserve {
+func mustOverReserve(t *testing.T, client ctrlclient.WithWatch, podLister podprovider.PodLister) *OverReserve {
 	obj, err := NewOverReserve(context.Background(), klog.Background(), nil, client, podLister, podprovider.IsPodRelevantAlways)
 	if err != nil {
 		t.Fatalf("unexpected error creating cache: %v", err)
@@ -17,6 +17,7 @@ limitations under the License.
 package cache
 
 import (
+	"slices"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -79,26 +80,35 @@ func (nrs *nrtStore) Update(nrt *topologyv1alpha2.NodeResourceTopology) {
 type resourceStore struct {
 	// key: namespace + "/" name
 	data map[string]corev1.ResourceList
-	lh   logr.Logger
+	// zones maps the pods, using the same key of data, to the zones they are expected to be placed on, if known.
+	zones map[string][]string
+	lh    logr.Logger
 }
 
 func newResourceStore(lh logr.Logger) *resourceStore {
 	return &resourceStore{
-		data: make(map[string]corev1.ResourceList),
-		lh:   lh,
+		data:  make(map[string]corev1.ResourceList),
+		zones: make(map[string][]string),
+		lh:    lh,
 	}
 }
 
 func (rs *resourceStore) String() string {
 	var sb strings.Builder
 	for podKey, podRes := range rs.data {
-		sb.WriteString(podKey + "::[" + stringify.ResourceList(podRes) + "];")
+		sb.WriteString(podKey + "::[" + stringify.ResourceList(podRes) + "]")
+		if zones, ok := rs.zones[podKey]; ok {
+			sb.WriteString("@[" + strings.Join(zones, ",") + "]")
+		}
+		sb.WriteString(";")
 	}
 	return sb.String()
 }
 
-// AddPod returns true if updating existing pod, false if adding for the first time
-func (rs *resourceStore) AddPod(pod *corev1.Pod) bool {
+// AddPod returns true if updating existing pod, false if adding for the first time.
+// If zones are given, the pod resources are accounted only on these zones; otherwise
+// they are accounted on all the zones.
+func (rs *resourceStore) AddPod(pod *corev1.Pod, zones ...string) bool {
 	key := pod.Namespace + "/" + pod.Name
 	_, ok := rs.data[key]
 	if ok {
@@ -106,8 +116,13 @@ func (rs *resourceStore) AddPod(pod *corev1.Pod) bool {
 		rs.lh.V(4).Info("updating existing entry", "key", key)
 	}
 	resData := util.GetPodEffectiveRequest(pod)
-	rs.lh.V(5).Info("resourcestore ADD", stringify.ResourceListToLoggable(resData)...)
+	rs.lh.V(5).Info("resourcestore ADD", stringify.ResourceListToLoggableWithValues([]interface{}{"zones", zones}, resData)...)
 	rs.data[key] = resData
+	if len(zones) > 0 {
+		rs.zones[key] = slices.Clone(zones)
+	} else {
+		delete(rs.zones, key)
+	}
 	return ok
 }
 
@@ -121,20 +136,31 @@ func (rs *resourceStore) DeletePod(pod *corev1.Pod) bool {
 	}
 	rs.lh.V(5).Info("resourcestore DEL", stringify.ResourceListToLoggable(rs.data[key])...)
 	delete(rs.data, key)
+	delete(rs.zones, key)
 	return ok
 }
 
-// UpdateNRT updates the provided Node Resource Topology object with the resources tracked in this store,
-// performing pessimistic overallocation across all the NUMA zones.
+// UpdateNRT updates the provided Node Resource Topology object with the resources tracked in this store.
+// The resources of pods whose zones are known are decremented only from these zones, otherwise the store
+// performs pessimistic overallocation across all the NUMA zones.
 func (rs *resourceStore) UpdateNRT(nrt *topologyv1alpha2.NodeResourceTopology, logKeysAndValues ...any) {
 	for key, res := range rs.data {
-		// We cannot predict on which Zone the workload will be placed.
-		// And we should totally not guess. So the only safe (and conservative)
-		// choice is to decrement the available resources from *all* the zones.
+		// Unless the placement was unambiguous, we cannot predict on which Zone the
+		// workload will be placed. And we should totally not guess. So the only safe
+		// (and conservative) choice is to decrement the available resources from *all* the zones.
 		// This can cause false negatives, but will never cause false positives,
 		// which are much worse.
+		podZones := rs.zones[key]
+		if len(podZones) > 0 && !slices.ContainsFunc(nrt.Zones, func(zone topologyv1alpha2.Zone) bool { return slices.Contains(podZones, zone.Name) }) {
+			// the zones changed meanwhile, so the placement is not reliable anymore
+			rs.lh.V(4).Info("unknown placement zones", append(logKeysAndValues, logging.KeyNode, nrt.Name, "requestor", key, "zones", podZones)...)
+			podZones = nil
+		}
 		for zi := 0; zi < len(nrt.Zones); zi++ {
 			zone := &nrt.Zones[zi] // shortcut
+			if len(podZones) > 0 && !slices.Contains(podZones, zone.Name) {
+				continue
+			}
 			for ri := 0; ri < len(zone.Resources); ri++ {
 				zr := &zone.Resources[ri] // shortcut
 				qty, ok := res[corev1.ResourceName(zr.Name)]
@@ -565,6 +565,104 @@ func TestResourceStoreUpdate(t *testing.T) {
 	}
 }
 
+func TestResourceStoreUpdateWithZones(t *testing.T) {
+	makeNRT := func() *topologyv1alpha2.NodeResourceTopology {
+		return &topologyv1alpha2.NodeResourceTopology{
+			ObjectMeta:       metav1.ObjectMeta{Name: "node"},
+			TopologyPolicies: []string{string(topologyv1alpha2.SingleNUMANodePodLevel)},
+			Zones: topologyv1alpha2.ZoneList{
+				{
+					Name: "node-0",
+					Type: "Node",
+					Resources: topologyv1alpha2.ResourceInfoList{
+						MakeTopologyResInfo(cpu, "20", "20"),
+						MakeTopologyResInfo(memory, "32Gi", "32Gi"),
+					},
+				},
+				{
+					Name: "node-1",
+					Type: "Node",
+					Resources: topologyv1alpha2.ResourceInfoList{
+						MakeTopologyResInfo(cpu, "20", "20"),
+						MakeTopologyResInfo(memory, "32Gi", "32Gi"),
+					},
+				},
+			},
+		}
+	}
+
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-0",
+			Name:      "pod-0",
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "cnt-0",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("16"),
+							corev1.ResourceMemory: resource.MustParse("4Gi"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tcases := []struct {
+		description  string
+		zones        []string
+		expectedCPUs []string
+	}{
+		{
+			description:  "unknown zones",
+			expectedCPUs: []string{"4", "4"},
+		},
+		{
+			description:  "known zone",
+			zones:        []string{"node-1"},
+			expectedCPUs: []string{"20", "4"},
+		},
+		{
+			description:  "known zones",
+			zones:        []string{"node-0", "node-1"},
+			expectedCPUs: []string{"4", "4"},
+		},
+		{
+			description:  "stale zone",
+			zones:        []string{"node-2"},
+			expectedCPUs: []string{"4", "4"},
+		},
+	}
+
+	for _, tc := range tcases {
+		t.Run(tc.description, func(t *testing.T) {
+			nrt := makeNRT()
+			rs := newResourceStore(klog.Background())
+			rs.AddPod(&pod, tc.zones...)
+			rs.UpdateNRT(nrt, "logID", "testResourceStoreUpdateWithZones")
+
+			for zi, expected := range tc.expectedCPUs {
+				cpuInfo := findResourceInfo(nrt.Zones[zi].Resources, cpu)
+				if cpuInfo.Available.Cmp(resource.MustParse(expected)) != 0 {
+					t.Errorf("bad availability for resource %q on zone %d: expected %v got %v", cpu, zi, expected, cpuInfo.Available)
+				}
+			}
+		})
+	}
+
+	rs := newResourceStore(klog.Background())
+	rs.AddPod(&pod, "node-1")
+	rs.AddPod(&pod)
+	nrt := makeNRT()
+	rs.UpdateNRT(nrt)
+	if cpuInfo := findResourceInfo(nrt.Zones[0].Resources, cpu); cpuInfo.Available.Cmp(resource.MustParse("4")) != 0 {
+		t.Errorf("zones not reset updating the pod: got %v available on zone 0", cpuInfo.Available)
+	}
+}
+
 func TestCheckPodFingerprintForNode(t *testing.T) {
 	tcases := []struct {
 		description string
@@ -602,6 +700,10 @@ func findResourceInfo(rinfos []topologyv1alpha2.ResourceInfo, name string) *topo
 type fakePodLister struct {
 	pods []*corev1.Pod
 	err  error
+	// listCalls counts the calls to List, which lists all the pods.
+	listCalls int
+	// onListByNode, if set, is called on every ListByNode.
+	onListByNode func(nodeName string)
 }
 
 type fakePodNamespaceLister struct {
@@ -614,9 +716,23 @@ func (fpl *fakePodLister) AddPod(pod *corev1.Pod) {
 }
 
 func (fpl *fakePodLister) List(selector labels.Selector) ([]*corev1.Pod, error) {
+	fpl.listCalls++
 	return fpl.pods, fpl.err
 }
 
+func (fpl *fakePodLister) ListByNode(nodeName string) ([]*corev1.Pod, error) {
+	if fpl.onListByNode != nil {
+		fpl.onListByNode(nodeName)
+	}
+	var pods []*corev1.Pod
+	for _, pod := range fpl.pods {
+		if pod.Spec.NodeName == nodeName {
+			pods = append(pods, pod)
+		}
+	}
+	return pods, fpl.err
+}
+
 func (fpl *fakePodLister) Pods(namespace string) podlisterv1.PodNamespaceLister {
 	return &fakePodNamespaceLister{
 		parent:    fpl,
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package noderesourcetopology
+
+import (
+	"gonum.org/v1/gonum/stat"
+
+	v1 "k8s.io/api/core/v1"
+	v1qos "k8s.io/kubernetes/pkg/apis/core/v1/helper/qos"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"github.com/go-logr/logr"
+	topologyv1alpha2 "github.com/k8stopologyawareschedwg/noderesourcetopology-api/pkg/apis/topology/v1alpha2"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/util"
+)
+
+// distanceWeightedPodScopeScore scores the node using the narrowest set of NUMA nodes able to fit the whole pod,
+// which is what the kubelet would pick with the restricted and best-effort policies, and scales the score
+// by the distance between the NUMA nodes in the set.
+func distanceWeightedPodScopeScore(lh logr.Logger, pod *v1.Pod, zones topologyv1alpha2.ZoneList, scorerFn scoreStrategyFn, resourceToWeightMap resourceToWeightMap) (int64, *framework.Status) {
+	nodes := createNUMANodeList(lh, zones)
+	qos := v1qos.GetPodQOS(pod)
+
+	resources := util.GetPodEffectiveRequest(pod)
+	// if a pod requests only non NUMA resources return max score
+	if onlyNonNUMAResources(nodes, resources) {
+		return framework.MaxNodeScore, nil
+	}
+
+	combination, _ := narrowestSuitableCombination(lh, qos, nodes, resources)
+	if combination == nil {
+		// score plugin should be running after resource filter plugin so we should always find sufficient amount of NUMA nodes
+		lh.Info("cannot find NUMA nodes to fit pod")
+		return framework.MinNodeScore, nil
+	}
+
+	finalScore := scoreCombination(lh, nodes, combination, resources, scorerFn, resourceToWeightMap)
+	lh.V(2).Info("pod scope distance weighted scoring final node score", "finalScore", finalScore)
+	return finalScore, nil
+}
+
+func distanceWeightedContainerScopeScore(lh logr.Logger, pod *v1.Pod, zones topologyv1alpha2.ZoneList, scorerFn scoreStrategyFn, resourceToWeightMap resourceToWeightMap) (int64, *framework.Status) {
+	nodes := createNUMANodeList(lh, zones)
+	qos := v1qos.GetPodQOS(pod)
+
+	var contScore []float64
+	// the order how TopologyManager asks for hint is important so doing it in the same order
+	// https://github.com/kubernetes/kubernetes/blob/master/pkg/kubelet/cm/topologymanager/scope_container.go#L52
+	for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		// if a container requests only non NUMA just continue
+		if onlyNonNUMAResources(nodes, container.Resources.Requests) {
+			continue
+		}
+		combination, _ := narrowestSuitableCombination(lh, qos, nodes, container.Resources.Requests)
+		if combination == nil {
+			// score plugin should be running after resource filter plugin so we should always find sufficient amount of NUMA nodes
+			lh.Info("cannot find NUMA nodes to fit container", "container", container.Name)
+			return framework.MinNodeScore, nil
+		}
+
+		score := scoreCombination(lh, nodes, combination, container.Resources.Requests, scorerFn, resourceToWeightMap)
+		lh.V(6).Info("container scope distance weighted scoring", "container", container.Name, "score", score)
+		contScore = append(contScore, float64(score))
+
+		// subtract the resources requested by the container from the given NUMA.
+		// this is necessary, so we won't allocate the same resources for the upcoming containers
+		subtractFromNUMAs(container.Resources.Requests, nodes, combination...)
+	}
+
+	if len(contScore) == 0 {
+		return framework.MaxNodeScore, nil
+	}
+
+	finalScore := int64(stat.Mean(contScore, nil))
+	lh.V(2).Info("container scope distance weighted scoring final node score", "finalScore", finalScore)
+	return finalScore, nil
+}
+
+// scoreCombination runs the scoring strategy against the resources aggregated over the NUMA nodes in combination,
+// and scales the result by the distance factor of the combination.
+func scoreCombination(lh logr.Logger, nodes NUMANodeList, combination []int, resources v1.ResourceList, scorerFn scoreStrategyFn, resourceToWeightMap resourceToWeightMap) int64 {
+	score := scorerFn(resources, combineResources(nodes, combination), resourceToWeightMap)
+	factor := distanceFactor(lh, nodes, combination)
+	lh.V(6).Info("distance weighted score", "combination", combination, "score", score, "distanceFactor", factor)
+	return int64(float64(score) * factor)
+}
+
+// distanceFactor returns the ratio between the local distance of the NUMA nodes in combination
+// and the average distance among them. The factor is 1 for a single NUMA node and decreases
+// as the NUMA nodes get further apart.
+func distanceFactor(lh logr.Logger, nodes NUMANodeList, combination []int) float64 {
+	if len(combination) <= 1 {
+		return 1
+	}
+	var localDistance float32
+	for _, nodeIdx := range combination {
+		localDistance += nodesAvgDistance(lh, nodes, nodeIdx)
+	}
+	localDistance /= float32(len(combination))
+
+	avgDistance := nodesAvgDistance(lh, nodes, combination...)
+	if avgDistance <= 0 || localDistance >= avgDistance {
+		return 1
+	}
+	return float64(localDistance / avgDistance)
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package noderesourcetopology
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+
+	topologyv1alpha2 "github.com/k8stopologyawareschedwg/noderesourcetopology-api/pkg/apis/topology/v1alpha2"
+)
+
+// twoSocketsDistances models two sockets with two NUMA nodes each
+var twoSocketsDistances = [][]int64{
+	{10, 12, 20, 20},
+	{12, 10, 20, 20},
+	{20, 20, 10, 12},
+	{20, 20, 12, 10},
+}
+
+func makeZonesWithCosts(distances [][]int64, cpus ...string) topologyv1alpha2.ZoneList {
+	zones := topologyv1alpha2.ZoneList{}
+	for idx, cpuQty := range cpus {
+		zone := topologyv1alpha2.Zone{
+			Name: fmt.Sprintf("node-%d", idx),
+			Type: "Node",
+			Resources: topologyv1alpha2.ResourceInfoList{
+				MakeTopologyResInfo(cpu, cpuQty, cpuQty),
+				MakeTopologyResInfo(memory, "4Gi", "4Gi"),
+			},
+		}
+		for peer, value := range distances[idx] {
+			zone.Costs = append(zone.Costs, topologyv1alpha2.CostInfo{
+				Name:  fmt.Sprintf("node-%d", peer),
+				Value: value,
+			})
+		}
+		zones = append(zones, zone)
+	}
+	return zones
+}
+
+func TestDistanceFactor(t *testing.T) {
+	nodes := createNUMANodeList(klog.Background(), makeZonesWithCosts(twoSocketsDistances, "4", "4", "4", "4"))
+
+	tcases := []struct {
+		description string
+		combination []int
+		expected    float64
+	}{
+		{
+			description: "single numa node",
+			combination: []int{2},
+			expected:    1,
+		},
+		{
+			description: "numa nodes on the same socket",
+			combination: []int{0, 1},
+			expected:    float64(float32(10) / float32(11)),
+		},
+		{
+			description: "numa nodes on different sockets",
+			combination: []int{0, 2},
+			expected:    float64(float32(10) / float32(15)),
+		},
+	}
+	for _, tc := range tcases {
+		t.Run(tc.description, func(t *testing.T) {
+			factor := distanceFactor(klog.Background(), nodes, tc.combination)
+			if factor != tc.expected {
+				t.Errorf("expected distance factor %f got %f", tc.expected, factor)
+			}
+		})
+	}
+}
+
+func TestDistanceWeightedScore(t *testing.T) {
+	guaranteed := v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("6"),
+		v1.ResourceMemory: resource.MustParse("1Gi"),
+	}
+
+	tcases := []struct {
+		description string
+		zones       topologyv1alpha2.ZoneList
+		pod         *v1.Pod
+		podScope    bool
+		expected    int64
+	}{
+		{
+			// LeastAllocated on the zone pair: cpu (8-6)/8 = 25, memory (8Gi-1Gi)/8Gi = 87 -> 56, scaled by 10/11
+			description: "pod scope, closest numa nodes available",
+			zones:       makeZonesWithCosts(twoSocketsDistances, "4", "4", "4", "4"),
+			pod:         makePodByResourceList(&guaranteed),
+			podScope:    true,
+			expected:    50,
+		},
+		{
+			// same raw score, but the only zone pair able to fit the pod spans sockets, scaled by 10/15
+			description: "pod scope, only distant numa nodes available",
+			zones:       makeZonesWithCosts(twoSocketsDistances, "4", "1", "4", "1"),
+			pod:         makePodByResourceList(&guaranteed),
+			podScope:    true,
+			expected:    37,
+		},
+		{
+			// cpu (8-6)/8 = 25, memory (4Gi-1Gi)/4Gi = 75, no distance penalty
+			description: "pod scope, fits in a single numa node",
+			zones:       makeZonesWithCosts(twoSocketsDistances, "8", "8", "8", "8"),
+			pod:         makePodByResourceList(&guaranteed),
+			podScope:    true,
+			expected:    50,
+		},
+		{
+			description: "container scope, only distant numa nodes available",
+			zones:       makeZonesWithCosts(twoSocketsDistances, "4", "1", "4", "1"),
+			pod:         makePodByResourceLists(guaranteed),
+			expected:    37,
+		},
+		{
+			description: "container scope, no room for the pod",
+			zones:       makeZonesWithCosts(twoSocketsDistances, "1", "1", "1", "1"),
+			pod:         makePodByResourceLists(guaranteed),
+			expected:    0,
+		},
+	}
+	for _, tc := range tcases {
+		t.Run(tc.description, func(t *testing.T) {
+			var score int64
+			if tc.podScope {
+				score, _ = distanceWeightedPodScopeScore(klog.Background(), tc.pod, tc.zones, leastAllocatedScoreStrategy, resourceToWeightMap{})
+			} else {
+				score, _ = distanceWeightedContainerScopeScore(klog.Background(), tc.pod, tc.zones, leastAllocatedScoreStrategy, resourceToWeightMap{})
+			}
+			if score != tc.expected {
+				t.Errorf("expected score %d got %d", tc.expected, score)
+			}
+		})
+	}
+}
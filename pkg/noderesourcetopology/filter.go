@@ -42,6 +42,13 @@ const highestNUMAID = 8
 type PolicyHandler func(pod *v1.Pod, zoneMap topologyv1alpha2.ZoneList) *framework.Status
 
 func singleNUMAContainerLevelHandler(lh logr.Logger, pod *v1.Pod, zones topologyv1alpha2.ZoneList, nodeInfo *framework.NodeInfo) *framework.Status {
+	_, status := singleNUMAContainerLevelPlacement(lh, pod, zones, nodeInfo)
+	return status
+}
+
+// singleNUMAContainerLevelPlacement returns the IDs of the NUMA nodes the containers requesting NUMA resources are expected to be placed on.
+// No ID is returned if any of these containers fits on more than one NUMA node, because which one the kubelet picks is then unknown.
+func singleNUMAContainerLevelPlacement(lh logr.Logger, pod *v1.Pod, zones topologyv1alpha2.ZoneList, nodeInfo *framework.NodeInfo) ([]int, *framework.Status) {
 	lh.V(5).Info("container level single NUMA node handler")
 
 	// prepare NUMANodes list from zoneMap
@@ -51,6 +58,9 @@ func singleNUMAContainerLevelHandler(lh logr.Logger, pod *v1.Pod, zones topology
 	// Node() != nil already verified in Filter(), which is the only public entry point
 	logNumaNodes(lh, "container handler NUMA resources", nodeInfo.Node().Name, nodes)
 
+	placement := bm.NewEmptyBitMask()
+	ambiguous := false
+
 	// the init containers are running SERIALLY and BEFORE the normal containers.
 	// https://kubernetes.io/docs/concepts/workloads/pods/init-containers/#understanding-init-containers
 	// therefore, we don't need to accumulate their resources together
@@ -59,11 +69,15 @@ func singleNUMAContainerLevelHandler(lh logr.Logger, pod *v1.Pod, zones topology
 		clh := lh.WithValues(logging.KeyContainer, initContainer.Name, logging.KeyContainerKind, logging.KindContainerInit)
 		clh.V(6).Info("desired resources", stringify.ResourceListToLoggable(initContainer.Resources.Requests)...)
 
-		_, match := resourcesAvailableInAnyNUMANodes(clh, nodes, initContainer.Resources.Requests, qos, nodeInfo)
+		numaIDs, match := numaNodesFittingResources(clh, nodes, initContainer.Resources.Requests, qos, nodeInfo)
 		if !match {
 			// we can't align init container, so definitely we can't align a pod
 			clh.V(2).Info("cannot align container")
-			return framework.NewStatus(framework.Unschedulable, "cannot align init container")
+			return nil, framework.NewStatus(framework.Unschedulable, "cannot align init container")
+		}
+		if !onlyNonNUMAResources(nodes, initContainer.Resources.Requests) {
+			placement.Add(numaIDs[0])
+			ambiguous = ambiguous || len(numaIDs) > 1
 		}
 	}
 
@@ -71,29 +85,51 @@ func singleNUMAContainerLevelHandler(lh logr.Logger, pod *v1.Pod, zones topology
 		clh := lh.WithValues(logging.KeyContainer, container.Name, logging.KeyContainerKind, logging.KindContainerApp)
 		clh.V(6).Info("container requests", stringify.ResourceListToLoggable(container.Resources.Requests)...)
 
-		numaID, match := resourcesAvailableInAnyNUMANodes(clh, nodes, container.Resources.Requests, qos, nodeInfo)
+		numaIDs, match := numaNodesFittingResources(clh, nodes, container.Resources.Requests, qos, nodeInfo)
 		if !match {
 			// we can't align container, so definitely we can't align a pod
 			clh.V(2).Info("cannot align container")
-			return framework.NewStatus(framework.Unschedulable, "cannot align container")
+			return nil, framework.NewStatus(framework.Unschedulable, "cannot align container")
 		}
+		// see resourcesAvailableInAnyNUMANodes for the rationale
+		numaID := numaIDs[0]
 
 		// subtract the resources requested by the container from the given NUMA.
 		// this is necessary, so we won't allocate the same resources for the upcoming containers
 		err := subtractResourcesFromNUMANodeList(clh, nodes, numaID, qos, container.Resources.Requests)
 		if err != nil {
 			// this is an internal error which should never happen
-			return framework.NewStatus(framework.Error, "inconsistent resource accounting", err.Error())
+			return nil, framework.NewStatus(framework.Error, "inconsistent resource accounting", err.Error())
+		}
+		if !onlyNonNUMAResources(nodes, container.Resources.Requests) {
+			placement.Add(numaID)
+			ambiguous = ambiguous || len(numaIDs) > 1
 		}
 		clh.V(4).Info("container aligned", "numaCell", numaID)
 	}
-	return nil
+	if ambiguous {
+		return nil, nil
+	}
+	return placement.GetBits(), nil
 }
 
 // resourcesAvailableInAnyNUMANodes checks for sufficient resource and return the NUMAID that would be selected by Kubelet.
 // this function requires NUMANodeList with properly populated NUMANode, NUMAID should be in range 0-63
 func resourcesAvailableInAnyNUMANodes(lh logr.Logger, numaNodes NUMANodeList, resources v1.ResourceList, qos v1.PodQOSClass, nodeInfo *framework.NodeInfo) (int, bool) {
-	numaID := highestNUMAID
+	numaIDs, ok := numaNodesFittingResources(lh, numaNodes, resources, qos, nodeInfo)
+	if !ok {
+		return highestNUMAID, false
+	}
+	// according to TopologyManager, the preferred NUMA affinity, is the narrowest one.
+	// https://github.com/kubernetes/kubernetes/blob/v1.24.0-rc.1/pkg/kubelet/cm/topologymanager/policy.go#L155
+	// in single-numa-node policy all resources should be allocated from a single NUMA,
+	// which means that the lowest NUMA ID (with available resources) is the one to be selected by Kubelet.
+	return numaIDs[0], true
+}
+
+// numaNodesFittingResources checks for sufficient resource and returns the sorted IDs of the NUMA nodes which fit them all.
+// this function requires NUMANodeList with properly populated NUMANode, NUMAID should be in range 0-63
+func numaNodesFittingResources(lh logr.Logger, numaNodes NUMANodeList, resources v1.ResourceList, qos v1.PodQOSClass, nodeInfo *framework.NodeInfo) ([]int, bool) {
 	bitmask := bm.NewEmptyBitMask()
 	// set all bits, each bit is a NUMA node, if resources couldn't be aligned
 	// on the NUMA node, bit should be unset
@@ -113,7 +149,7 @@ func resourcesAvailableInAnyNUMANodes(lh logr.Logger, numaNodes NUMANodeList, re
 			// must be reported at node level; thus, if they are not present at node level, we can safely assume
 			// we don't have the resource at all.
 			lh.V(2).Info("early verdict: cannot meet request", "resource", resource, "suitable", "false")
-			return numaID, false
+			return nil, false
 		}
 
 		// for each requested resource, calculate which NUMA slots are good fits, and then AND with the aggregated bitmask, IOW unset appropriate bit if we can't align resources, or set it
@@ -145,22 +181,24 @@ func resourcesAvailableInAnyNUMANodes(lh logr.Logger, numaNodes NUMANodeList, re
 		bitmask.And(resourceBitmask)
 		if bitmask.IsEmpty() {
 			lh.V(2).Info("early verdict", "resource", resource, "suitable", "false")
-			return numaID, false
+			return nil, false
 		}
 	}
-	// according to TopologyManager, the preferred NUMA affinity, is the narrowest one.
-	// https://github.com/kubernetes/kubernetes/blob/v1.24.0-rc.1/pkg/kubelet/cm/topologymanager/policy.go#L155
-	// in single-numa-node policy all resources should be allocated from a single NUMA,
-	// which means that the lowest NUMA ID (with available resources) is the one to be selected by Kubelet.
-	numaID = bitmask.GetBits()[0]
-
 	// at least one NUMA node is available
 	ret := !bitmask.IsEmpty()
-	lh.V(2).Info("final verdict", "suitable", ret, "numaCell", numaID)
-	return numaID, ret
+	numaIDs := bitmask.GetBits()
+	lh.V(2).Info("final verdict", "suitable", ret, "numaCells", numaIDs)
+	return numaIDs, ret
 }
 
 func singleNUMAPodLevelHandler(lh logr.Logger, pod *v1.Pod, zones topologyv1alpha2.ZoneList, nodeInfo *framework.NodeInfo) *framework.Status {
+	_, status := singleNUMAPodLevelPlacement(lh, pod, zones, nodeInfo)
+	return status
+}
+
+// singleNUMAPodLevelPlacement returns the ID of the NUMA node the pod is expected to be placed on, if the pod requests NUMA resources
+// and fits on a single NUMA node: otherwise which one the kubelet picks is unknown.
+func singleNUMAPodLevelPlacement(lh logr.Logger, pod *v1.Pod, zones topologyv1alpha2.ZoneList, nodeInfo *framework.NodeInfo) ([]int, *framework.Status) {
 	lh.V(5).Info("pod level single NUMA node handler")
 
 	resources := util.GetPodEffectiveRequest(pod)
@@ -171,16 +209,19 @@ func singleNUMAPodLevelHandler(lh logr.Logger, pod *v1.Pod, zones topologyv1alph
 	logNumaNodes(lh, "pod handler NUMA resources", nodeInfo.Node().Name, nodes)
 	lh.V(6).Info("pod desired resources", stringify.ResourceListToLoggable(resources)...)
 
-	numaID, match := resourcesAvailableInAnyNUMANodes(lh, createNUMANodeList(lh, zones), resources, v1qos.GetPodQOS(pod), nodeInfo)
+	numaIDs, match := numaNodesFittingResources(lh, createNUMANodeList(lh, zones), resources, v1qos.GetPodQOS(pod), nodeInfo)
 	if !match {
 		lh.V(2).Info("cannot align pod", "name", pod.Name)
-		return framework.NewStatus(framework.Unschedulable, "cannot align pod")
+		return nil, framework.NewStatus(framework.Unschedulable, "cannot align pod")
 	}
-	lh.V(4).Info("all container placed", "numaCell", numaID)
-	return nil
+	lh.V(4).Info("all container placed", "numaCell", numaIDs[0])
+	if len(numaIDs) > 1 || onlyNonNUMAResources(nodes, resources) {
+		return nil, nil
+	}
+	return numaIDs, nil
 }
 
-// Filter Now only single-numa-node supported
+// Filter supports the single-numa-node and restricted policies; the other policies never reject pods
 func (tm *TopologyMatch) Filter(ctx context.Context, cycleState *framework.CycleState, pod *v1.Pod, nodeInfo *framework.NodeInfo) *framework.Status {
 	if nodeInfo.Node() == nil {
 		return framework.NewStatus(framework.Error, "node not found")
@@ -210,11 +251,16 @@ func (tm *TopologyMatch) Filter(ctx context.Context, cycleState *framework.Cycle
 
 	lh.V(4).Info("found nrt data", "object", stringify.NodeResourceTopologyResources(nodeTopology), "conf", conf.String())
 
-	handler := filterHandlerFromTopologyManager(conf)
-	if handler == nil {
-		return nil
+	var status *framework.Status
+	if placement := placementHandlerFromTopologyManager(conf); placement != nil {
+		var numaIDs []int
+		numaIDs, status = placement(lh, pod, nodeTopology.Zones, nodeInfo)
+		if status == nil {
+			writePlacementState(lh, cycleState, pod, nodeName, nodeTopology.Zones, numaIDs)
+		}
+	} else if handler := filterHandlerFromTopologyManager(conf); handler != nil {
+		status = handler(lh, pod, nodeTopology.Zones, nodeInfo)
 	}
-	status := handler(lh, pod, nodeTopology.Zones, nodeInfo)
 	if status != nil {
 		tm.nrtCache.NodeMaybeOverReserved(nodeName, pod)
 	}
@@ -222,14 +268,22 @@ func (tm *TopologyMatch) Filter(ctx context.Context, cycleState *framework.Cycle
 }
 
 func filterHandlerFromTopologyManager(conf nodeconfig.TopologyManager) filterFn {
-	if conf.Policy != kubeletconfig.SingleNumaNodeTopologyManagerPolicy {
-		return nil
-	}
-	if conf.Scope == kubeletconfig.PodTopologyManagerScope {
-		return singleNUMAPodLevelHandler
-	}
-	if conf.Scope == kubeletconfig.ContainerTopologyManagerScope {
-		return singleNUMAContainerLevelHandler
+	switch conf.Policy {
+	case kubeletconfig.SingleNumaNodeTopologyManagerPolicy:
+		if conf.Scope == kubeletconfig.PodTopologyManagerScope {
+			return singleNUMAPodLevelHandler
+		}
+		if conf.Scope == kubeletconfig.ContainerTopologyManagerScope {
+			return singleNUMAContainerLevelHandler
+		}
+	case kubeletconfig.RestrictedTopologyManagerPolicy:
+		if conf.Scope == kubeletconfig.PodTopologyManagerScope {
+			return restrictedPodLevelHandler(conf)
+		}
+		if conf.Scope == kubeletconfig.ContainerTopologyManagerScope {
+			return restrictedContainerLevelHandler(conf)
+		}
 	}
-	return nil // cannot happen
+	// the best-effort policy always admits pods, like the none policy does
+	return nil
 }
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package noderesourcetopology
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	v1qos "k8s.io/kubernetes/pkg/apis/core/v1/helper/qos"
+	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	ctrlruntimecache "sigs.k8s.io/controller-runtime/pkg/cache"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	nrtcache "sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology/cache"
+	"sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology/logging"
+	"sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology/nodeconfig"
+	"sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology/resourcerequests"
+	"sigs.k8s.io/scheduler-plugins/pkg/util"
+)
+
+// GangChecker verifies the members of a PodGroup can be placed each on a distinct NUMA zone,
+// which is what the single-numa-node policy requires to admit them. It complements the
+// cluster-wide resource check of the coscheduling plugin, which cannot see the NUMA fragmentation.
+type GangChecker struct {
+	// handle is the framework handle of the profile, whose NodeResourceTopologyMatch plugin cache,
+	// accounting the resources reserved by the plugin, is consumed if the plugin is enabled.
+	handle framework.Handle
+	// newFallback creates the NRT cache consumed if the profile has no NodeResourceTopologyMatch
+	// plugin, on first use.
+	newFallback  func() (nrtcache.Interface, error)
+	fallbackOnce sync.Once
+	fallback     nrtcache.Interface
+	fallbackErr  error
+}
+
+// NewGangChecker returns a GangChecker consuming the NRT data from the cache of the
+// NodeResourceTopologyMatch plugin of the profile of the given handle, or else from the fallback cache.
+func NewGangChecker(handle framework.Handle, fallback nrtcache.Interface) *GangChecker {
+	return &GangChecker{
+		handle:      handle,
+		newFallback: func() (nrtcache.Interface, error) { return fallback, nil },
+	}
+}
+
+// NewGangCheckerFromHandle returns a GangChecker for the profile of the given handle, falling back
+// to NRT data read from an informer. The informer is only started if the profile has no
+// NodeResourceTopologyMatch plugin, when the NRT data are first needed.
+func NewGangCheckerFromHandle(ctx context.Context, lh logr.Logger, handle framework.Handle) *GangChecker {
+	return &GangChecker{
+		handle: handle,
+		newFallback: func() (nrtcache.Interface, error) {
+			return newInformerNRTCache(ctx, lh, handle)
+		},
+	}
+}
+
+// newInformerNRTCache returns a NRT cache reading the NRT data from an informer.
+func newInformerNRTCache(ctx context.Context, lh logr.Logger, handle framework.Handle) (nrtcache.Interface, error) {
+	informerCache, err := ctrlruntimecache.New(handle.KubeConfig(), ctrlruntimecache.Options{Scheme: scheme})
+	if err != nil {
+		return nil, err
+	}
+	client, err := ctrlclient.New(handle.KubeConfig(), ctrlclient.Options{
+		Scheme: scheme,
+		Cache:  &ctrlclient.CacheOptions{Reader: informerCache},
+	})
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		if err := informerCache.Start(ctx); err != nil {
+			lh.Error(err, "failed to watch the NodeResourceTopologies")
+		}
+	}()
+	// the NRT informer is created and synced on the first read
+	if !informerCache.WaitForCacheSync(ctx) {
+		return nil, fmt.Errorf("failed to start the NodeResourceTopology cache")
+	}
+	return nrtcache.NewPassthrough(lh.WithName(logging.SubsystemNRTCache), client), nil
+}
+
+// nrtCache returns the NRT cache to consume.
+func (gc *GangChecker) nrtCache() (nrtcache.Interface, error) {
+	if gc.handle != nil {
+		if nrtCache, ok := ProfileCache(gc.handle); ok {
+			return nrtCache, nil
+		}
+	}
+	gc.fallbackOnce.Do(func() {
+		gc.fallback, gc.fallbackErr = gc.newFallback()
+	})
+	return gc.fallback, gc.fallbackErr
+}
+
+// CheckPodGroupResource returns an error if there are fewer NUMA zones able to fit <pod> than the
+// members of <pg> still to be placed. The members are expected to share the resource requests of <pod>.
+// Nodes without topology data, with stale topology data, or whose Topology Manager policy is not
+// single-numa-node, cannot guarantee the NUMA alignment and are not taken into account.
+func (gc *GangChecker) CheckPodGroupResource(ctx context.Context, nodeList []*framework.NodeInfo, pg *v1alpha1.PodGroup, pod *v1.Pod) error {
+	qos := v1qos.GetPodQOS(pod)
+	if qos == v1.PodQOSBestEffort && !resourcerequests.IncludeNonNative(pod) {
+		return nil
+	}
+
+	pgFullName := util.GetPodGroupFullName(pod)
+	lh := klog.FromContext(ctx).WithValues(logging.KeyPod, klog.KObj(pod), logging.KeyPodUID, logging.PodUID(pod), "podGroup", pgFullName)
+
+	// members already running or assumed do not need a NUMA zone anymore
+	needed := int(pg.Spec.MinMember)
+	for _, nodeInfo := range nodeList {
+		if nodeInfo == nil || nodeInfo.Node() == nil {
+			continue
+		}
+		for _, podInfo := range nodeInfo.Pods {
+			if podInfo == nil || podInfo.Pod == nil {
+				continue
+			}
+			if util.GetPodGroupFullName(podInfo.Pod) == pgFullName {
+				needed--
+			}
+		}
+	}
+	if needed <= 0 {
+		return nil
+	}
+
+	resources := util.GetPodEffectiveRequest(pod)
+	nrtCache, err := gc.nrtCache()
+	if err != nil {
+		return err
+	}
+	available := 0
+	for _, nodeInfo := range nodeList {
+		if nodeInfo == nil || nodeInfo.Node() == nil {
+			continue
+		}
+		nodeName := nodeInfo.Node().Name
+		nodeTopology, info := nrtCache.GetCachedNRTCopy(ctx, nodeName, pod)
+		if !info.Fresh || nodeTopology == nil {
+			lh.V(4).Info("skipping node without valid topology data", logging.KeyNode, nodeName)
+			continue
+		}
+		if conf := nodeconfig.TopologyManagerFromNodeResourceTopology(lh, nodeTopology); conf.Policy != kubeletconfig.SingleNumaNodeTopologyManagerPolicy {
+			lh.V(4).Info("skipping node without single NUMA node alignment", logging.KeyNode, nodeName, "conf", conf.String())
+			continue
+		}
+
+		numaNodes := createNUMANodeList(lh, nodeTopology.Zones)
+		if onlyNonNUMAResources(numaNodes, resources) {
+			lh.V(4).Info("pod requests only non NUMA resources")
+			return nil
+		}
+
+		for _, numaNode := range numaNodes {
+			if _, ok := resourcesAvailableInAnyNUMANodes(lh, NUMANodeList{numaNode}, resources, qos, nodeInfo); !ok {
+				continue
+			}
+			available++
+			if available >= needed {
+				lh.V(4).Info("enough NUMA zones for the pod group", "needed", needed)
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("NUMA zones gap: %d NUMA zones can fit the pod group members, need %d", available, needed)
+}
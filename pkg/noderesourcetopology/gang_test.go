@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package noderesourcetopology
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	topologyv1alpha2 "github.com/k8stopologyawareschedwg/noderesourcetopology-api/pkg/apis/topology/v1alpha2"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	nrtcache "sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology/cache"
+	tu "sigs.k8s.io/scheduler-plugins/test/util"
+)
+
+func makeGangMember(name string, resources v1.ResourceList) *v1.Pod {
+	pod := makePodByResourceList(&resources)
+	pod.Name = name
+	pod.Namespace = "ns"
+	pod.Labels = map[string]string{v1alpha1.PodGroupLabel: "pg1"}
+	return pod
+}
+
+func TestGangCheckerCheckPodGroupResource(t *testing.T) {
+	nrts := []*topologyv1alpha2.NodeResourceTopology{
+		{
+			ObjectMeta:       metav1.ObjectMeta{Name: "node-a"},
+			TopologyPolicies: []string{string(topologyv1alpha2.SingleNUMANodeContainerLevel)},
+			Zones:            makeRestrictedZones("4", "1"),
+		},
+		{
+			ObjectMeta:       metav1.ObjectMeta{Name: "node-b"},
+			TopologyPolicies: []string{string(topologyv1alpha2.SingleNUMANodePodLevel)},
+			Zones:            makeRestrictedZones("2", "4"),
+		},
+		// node-d does not align the resources on NUMA zones, so its zones cannot host the members
+		{
+			ObjectMeta:       metav1.ObjectMeta{Name: "node-d"},
+			TopologyPolicies: []string{string(topologyv1alpha2.BestEffortContainerLevel)},
+			Zones:            makeRestrictedZones("4", "4"),
+		},
+	}
+	// node-c has plenty of resources, but no topology data
+	nodeC := framework.NewNodeInfo()
+	nodeC.SetNode(makeNodeFromNodeResourceTopology(&topologyv1alpha2.NodeResourceTopology{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-c"},
+		Zones:      makeRestrictedZones("4", "4", "4", "4"),
+	}))
+
+	fakeClient, err := tu.NewFakeClient()
+	if err != nil {
+		t.Fatalf("failed to create fake client: %v", err)
+	}
+	var nodeInfos []*framework.NodeInfo
+	for _, nrt := range nrts {
+		if err := fakeClient.Create(context.Background(), nrt.DeepCopy()); err != nil {
+			t.Fatal(err)
+		}
+		nodeInfo := framework.NewNodeInfo()
+		nodeInfo.SetNode(makeNodeFromNodeResourceTopology(nrt))
+		nodeInfos = append(nodeInfos, nodeInfo)
+	}
+	nodeInfos = append(nodeInfos, nodeC)
+
+	// only a single NUMA zone on each of node-a and node-b can fit a member
+	member := cpuMemRequest("3", "1Gi")
+
+	tcases := []struct {
+		description string
+		minMember   int32
+		pod         *v1.Pod
+		runningPods []*v1.Pod
+		wantErr     bool
+	}{
+		{
+			description: "enough NUMA zones for the members",
+			minMember:   2,
+			pod:         makeGangMember("p1", member),
+		},
+		{
+			description: "NUMA fragmentation prevents placing the members",
+			minMember:   3,
+			pod:         makeGangMember("p1", member),
+			wantErr:     true,
+		},
+		{
+			description: "members already running need no NUMA zone",
+			minMember:   3,
+			pod:         makeGangMember("p1", member),
+			runningPods: []*v1.Pod{makeGangMember("p2", member)},
+		},
+		{
+			description: "best effort members need no NUMA alignment",
+			minMember:   3,
+			pod:         makeGangMember("p1", v1.ResourceList{}),
+		},
+	}
+
+	for _, tc := range tcases {
+		t.Run(tc.description, func(t *testing.T) {
+			nodes := make([]*framework.NodeInfo, 0, len(nodeInfos))
+			for _, nodeInfo := range nodeInfos {
+				nodes = append(nodes, nodeInfo.Snapshot())
+			}
+			for _, pod := range tc.runningPods {
+				nodes[len(nodes)-1].AddPod(pod)
+			}
+
+			pg := tu.MakePodGroup().Name("pg1").Namespace("ns").MinMember(tc.minMember).Obj()
+			gc := NewGangChecker(nil, nrtcache.NewPassthrough(klog.Background(), fakeClient))
+			err := gc.CheckPodGroupResource(context.Background(), nodes, pg, tc.pod)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("unexpected error: %v, want error: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestGangCheckerSharesProfileCache(t *testing.T) {
+	nrt := &topologyv1alpha2.NodeResourceTopology{
+		ObjectMeta:       metav1.ObjectMeta{Name: "node-a"},
+		TopologyPolicies: []string{string(topologyv1alpha2.SingleNUMANodeContainerLevel)},
+		Zones:            makeRestrictedZones("4", "4"),
+	}
+	fakeClient, err := tu.NewFakeClient(nrt)
+	if err != nil {
+		t.Fatalf("failed to create fake client: %v", err)
+	}
+	nodeInfo := framework.NewNodeInfo()
+	nodeInfo.SetNode(makeNodeFromNodeResourceTopology(nrt))
+	nodes := []*framework.NodeInfo{nodeInfo}
+	pg := tu.MakePodGroup().Name("pg1").Namespace("ns").MinMember(2).Obj()
+	member := makeGangMember("p1", cpuMemRequest("3", "1Gi"))
+
+	// the fallback cache cannot find the NRT, so only the cache of the profile lets the members fit
+	fallback := nrtcache.NewPassthrough(klog.Background(), mustNewFakeClient(t))
+	handle := &fakeHandle{}
+	gc := NewGangChecker(handle, fallback)
+	if err := gc.CheckPodGroupResource(context.Background(), nodes, pg, member); err == nil {
+		t.Fatal("want an error without the NRT data")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	registerProfileCache(ctx, handle, nrtcache.NewPassthrough(klog.Background(), fakeClient))
+	if err := gc.CheckPodGroupResource(context.Background(), nodes, pg, member); err != nil {
+		t.Errorf("unexpected error with the cache of the profile: %v", err)
+	}
+
+	// the cache of the profile is dropped once its framework stops
+	cancel()
+	if err := wait.PollUntilContextTimeout(context.Background(), 10*time.Millisecond, time.Second, true, func(context.Context) (bool, error) {
+		_, ok := ProfileCache(handle)
+		return !ok, nil
+	}); err != nil {
+		t.Errorf("the cache of the profile is kept after its framework stopped: %v", err)
+	}
+}
+
+func TestGangCheckerFallbackOnlyWithoutProfileCache(t *testing.T) {
+	nrt := &topologyv1alpha2.NodeResourceTopology{
+		ObjectMeta:       metav1.ObjectMeta{Name: "node-a"},
+		TopologyPolicies: []string{string(topologyv1alpha2.SingleNUMANodeContainerLevel)},
+		Zones:            makeRestrictedZones("4", "4"),
+	}
+	nodeInfo := framework.NewNodeInfo()
+	nodeInfo.SetNode(makeNodeFromNodeResourceTopology(nrt))
+	pg := tu.MakePodGroup().Name("pg1").Namespace("ns").MinMember(2).Obj()
+	member := makeGangMember("p1", cpuMemRequest("3", "1Gi"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	handle := &fakeHandle{}
+	registerProfileCache(ctx, handle, nrtcache.NewPassthrough(klog.Background(), mustNewFakeClient(t, nrt)))
+	fallbacks := 0
+	gc := &GangChecker{
+		handle: handle,
+		newFallback: func() (nrtcache.Interface, error) {
+			fallbacks++
+			return nil, fmt.Errorf("no fallback")
+		},
+	}
+	if err := gc.CheckPodGroupResource(context.Background(), []*framework.NodeInfo{nodeInfo}, pg, member); err != nil {
+		t.Errorf("unexpected error with the cache of the profile: %v", err)
+	}
+	if fallbacks != 0 {
+		t.Errorf("want no fallback cache created, got %d", fallbacks)
+	}
+}
+
+// fakeHandle stands for the framework handle of a profile.
+type fakeHandle struct {
+	framework.Handle
+}
+
+func mustNewFakeClient(t *testing.T, objs ...runtime.Object) ctrlclient.WithWatch {
+	fakeClient, err := tu.NewFakeClient(objs...)
+	if err != nil {
+		t.Fatalf("failed to create fake client: %v", err)
+	}
+	return fakeClient
+}
@@ -165,16 +165,27 @@ func combineResources(numaNodes NUMANodeList, combination []int) v1.ResourceList
 // or nil when resources can't be fitted onto the worker node
 // second value returned is a boolean indicating if bitmask is optimal from distance perspective
 func numaNodesRequired(lh logr.Logger, qos v1.PodQOSClass, numaNodes NUMANodeList, resources v1.ResourceList) (bitmask.BitMask, bool) {
-	for bitmaskLen := 1; bitmaskLen <= len(numaNodes); bitmaskLen++ {
-		numaNodesCombination := combin.Combinations(len(numaNodes), bitmaskLen)
+	suitableCombination, isMinDistance := narrowestSuitableCombination(lh, qos, numaNodes, resources)
+	if suitableCombination == nil {
+		return nil, false
+	}
+	bm := bitmask.NewEmptyBitMask()
+	for _, nodeIdx := range suitableCombination {
+		bm.Add(numaNodes[nodeIdx].NUMAID)
+	}
+	return bm, isMinDistance
+}
+
+// narrowestSuitableCombination returns the indexes in numaNodes of the smallest combination of NUMA nodes
+// which can fit the given resources, or nil when resources can't be fitted onto the worker node.
+// second value returned is a boolean indicating if the combination is optimal from distance perspective
+func narrowestSuitableCombination(lh logr.Logger, qos v1.PodQOSClass, numaNodes NUMANodeList, resources v1.ResourceList) ([]int, bool) {
+	for combinationLen := 1; combinationLen <= len(numaNodes); combinationLen++ {
+		numaNodesCombination := combin.Combinations(len(numaNodes), combinationLen)
 		suitableCombination, isMinDistance := findSuitableCombination(lh, qos, numaNodes, resources, numaNodesCombination)
-		// we have found suitable combination for given bitmaskLen
+		// we have found suitable combination for given combinationLen
 		if suitableCombination != nil {
-			bm := bitmask.NewEmptyBitMask()
-			for _, nodeIdx := range suitableCombination {
-				bm.Add(numaNodes[nodeIdx].NUMAID)
-			}
-			return bm, isMinDistance
+			return suitableCombination, isMinDistance
 		}
 	}
 
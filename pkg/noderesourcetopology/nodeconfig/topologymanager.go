@@ -18,6 +18,7 @@ package nodeconfig
 
 import (
 	"fmt"
+	"strconv"
 
 	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
 
@@ -28,9 +29,13 @@ import (
 const (
 	AttributeScope  = "topologyManagerScope"
 	AttributePolicy = "topologyManagerPolicy"
+	// policy options, each one exposed as separate attribute
+	AttributeOptionPreferClosestNUMANodes = "topologyManagerOptionPreferClosestNumaNodes"
+	AttributeOptionMaxAllowableNUMANodes  = "topologyManagerOptionMaxAllowableNumaNodes"
 )
 
-// TODO: handle topologyManagerPolicyOptions added in k8s 1.26
+// DefaultMaxAllowableNUMANodes is the maximum number of NUMA nodes the kubelet Topology Manager allows by default
+const DefaultMaxAllowableNUMANodes = 8
 
 func IsValidScope(scope string) bool {
 	if scope == kubeletconfig.ContainerTopologyManagerScope || scope == kubeletconfig.PodTopologyManagerScope {
@@ -50,6 +55,11 @@ func IsValidPolicy(policy string) bool {
 type TopologyManager struct {
 	Scope  string
 	Policy string
+	// PreferClosestNUMA reflects the prefer-closest-numa-nodes policy option
+	PreferClosestNUMA bool
+	// MaxAllowableNUMANodes reflects the max-allowable-numa-nodes policy option.
+	// Zero means the option is not set; use MaxNUMANodes to get the effective value.
+	MaxAllowableNUMANodes int
 }
 
 func TopologyManagerDefaults() TopologyManager {
@@ -69,8 +79,16 @@ func TopologyManagerFromNodeResourceTopology(lh logr.Logger, nodeTopology *topol
 	return conf
 }
 
+// MaxNUMANodes returns the maximum number of NUMA nodes the Topology Manager supports on the node
+func (conf TopologyManager) MaxNUMANodes() int {
+	if conf.MaxAllowableNUMANodes == 0 {
+		return DefaultMaxAllowableNUMANodes
+	}
+	return conf.MaxAllowableNUMANodes
+}
+
 func (conf TopologyManager) String() string {
-	return fmt.Sprintf("policy=%q scope=%q", conf.Policy, conf.Scope)
+	return fmt.Sprintf("policy=%q scope=%q preferClosestNUMA=%v maxNUMANodes=%d", conf.Policy, conf.Scope, conf.PreferClosestNUMA, conf.MaxNUMANodes())
 }
 
 func (conf TopologyManager) Equal(other TopologyManager) bool {
@@ -80,6 +98,12 @@ func (conf TopologyManager) Equal(other TopologyManager) bool {
 	if conf.Policy != other.Policy {
 		return false
 	}
+	if conf.PreferClosestNUMA != other.PreferClosestNUMA {
+		return false
+	}
+	if conf.MaxNUMANodes() != other.MaxNUMANodes() {
+		return false
+	}
 	return true
 }
 
@@ -93,7 +117,17 @@ func (conf *TopologyManager) updateFromAttributes(attrs topologyv1alpha2.Attribu
 			conf.Policy = attr.Value
 			continue
 		}
-		// TODO: handle topologyManagerPolicyOptions added in k8s 1.26
+		if attr.Name == AttributeOptionPreferClosestNUMANodes && (attr.Value == "true" || attr.Value == "false") {
+			conf.PreferClosestNUMA = (attr.Value == "true")
+			continue
+		}
+		if attr.Name == AttributeOptionMaxAllowableNUMANodes {
+			// the kubelet refuses values lower than the default
+			if val, err := strconv.Atoi(attr.Value); err == nil && val >= DefaultMaxAllowableNUMANodes {
+				conf.MaxAllowableNUMANodes = val
+			}
+			continue
+		}
 	}
 }
 
@@ -205,6 +205,32 @@ func TestTopologyManagerEqual(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name: "options diff",
+			tmA: TopologyManager{
+				Scope:             "container",
+				Policy:            "restricted",
+				PreferClosestNUMA: true,
+			},
+			tmB: TopologyManager{
+				Scope:  "container",
+				Policy: "restricted",
+			},
+			expected: false,
+		},
+		{
+			name: "max NUMA nodes default vs explicit",
+			tmA: TopologyManager{
+				Scope:                 "container",
+				Policy:                "restricted",
+				MaxAllowableNUMANodes: DefaultMaxAllowableNUMANodes,
+			},
+			tmB: TopologyManager{
+				Scope:  "container",
+				Policy: "restricted",
+			},
+			expected: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -292,6 +318,46 @@ func TestConfigFromAttributes(t *testing.T) {
 				Scope:  kubeletconfig.PodTopologyManagerScope,
 			},
 		},
+		{
+			name: "policy-options",
+			attrs: topologyv1alpha2.AttributeList{
+				{
+					Name:  "topologyManagerPolicy",
+					Value: "restricted",
+				},
+				{
+					Name:  "topologyManagerOptionPreferClosestNumaNodes",
+					Value: "true",
+				},
+				{
+					Name:  "topologyManagerOptionMaxAllowableNumaNodes",
+					Value: "16",
+				},
+			},
+			expected: TopologyManager{
+				Policy:                kubeletconfig.RestrictedTopologyManagerPolicy,
+				PreferClosestNUMA:     true,
+				MaxAllowableNUMANodes: 16,
+			},
+		},
+		{
+			name: "policy-options-invalid",
+			attrs: topologyv1alpha2.AttributeList{
+				{
+					Name:  "topologyManagerOptionPreferClosestNumaNodes",
+					Value: "maybe",
+				},
+				{
+					Name:  "topologyManagerOptionMaxAllowableNumaNodes",
+					Value: "4",
+				},
+				{
+					Name:  "topologyManagerOptionUnknown",
+					Value: "true",
+				},
+			},
+			expected: TopologyManager{},
+		},
 		{
 			name: "error-case-1",
 			attrs: topologyv1alpha2.AttributeList{
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package noderesourcetopology
+
+import (
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	v1qos "k8s.io/kubernetes/pkg/apis/core/v1/helper/qos"
+	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"github.com/go-logr/logr"
+	topologyv1alpha2 "github.com/k8stopologyawareschedwg/noderesourcetopology-api/pkg/apis/topology/v1alpha2"
+	"github.com/k8stopologyawareschedwg/noderesourcetopology-api/pkg/apis/topology/v1alpha2/helper/numanode"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology/nodeconfig"
+)
+
+// placementFn is a filterFn which also returns the IDs of the NUMA nodes the pod is expected to be placed on,
+// or none if the pod fits on several NUMA nodes and which one the kubelet picks is unknown.
+type placementFn func(lh logr.Logger, pod *v1.Pod, zones topologyv1alpha2.ZoneList, nodeInfo *framework.NodeInfo) ([]int, *framework.Status)
+
+// placementStateKeyPrefix is the prefix of the keys of the per-node placement state in the CycleState.
+// Filter runs concurrently on many nodes, so each node gets its own key.
+const placementStateKeyPrefix = Name + "/placement/"
+
+// placementState records the zones a pod is expected to be placed on by the kubelet.
+type placementState struct {
+	zones []string
+}
+
+// Clone shares the data, because it is never mutated after creation.
+func (ps *placementState) Clone() framework.StateData {
+	return ps
+}
+
+func placementStateKey(nodeName string) framework.StateKey {
+	return framework.StateKey(placementStateKeyPrefix + nodeName)
+}
+
+// placementHandlerFromTopologyManager returns the placementFn for the policies on which the kubelet decision
+// is predictable, which is the case of the single-numa-node policy, or nil otherwise.
+func placementHandlerFromTopologyManager(conf nodeconfig.TopologyManager) placementFn {
+	if conf.Policy != kubeletconfig.SingleNumaNodeTopologyManagerPolicy {
+		return nil
+	}
+	if conf.Scope == kubeletconfig.PodTopologyManagerScope {
+		return singleNUMAPodLevelPlacement
+	}
+	if conf.Scope == kubeletconfig.ContainerTopologyManagerScope {
+		return singleNUMAContainerLevelPlacement
+	}
+	return nil
+}
+
+// writePlacementState records the zones the pod is expected to be placed on, so Reserve can account the pod
+// resources only on these zones. Only guaranteed pods get exclusive resources from specific NUMA nodes,
+// so in any other case, or if the zones cannot be found, nothing is recorded and the accounting stays pessimistic.
+func writePlacementState(lh logr.Logger, cycleState *framework.CycleState, pod *v1.Pod, nodeName string, zones topologyv1alpha2.ZoneList, numaIDs []int) {
+	if len(numaIDs) == 0 || v1qos.GetPodQOS(pod) != v1.PodQOSGuaranteed {
+		return
+	}
+	zoneNames := zoneNamesFromNUMAIDs(zones, numaIDs)
+	if len(zoneNames) != len(numaIDs) {
+		lh.V(4).Info("cannot find the placement zones", "numaCells", numaIDs)
+		return
+	}
+	lh.V(4).Info("expected placement", "zones", zoneNames)
+	cycleState.Write(placementStateKey(nodeName), &placementState{zones: zoneNames})
+}
+
+// readPlacementZones returns the zones recorded by writePlacementState for the given node, if any.
+func readPlacementZones(cycleState *framework.CycleState, nodeName string) []string {
+	data, err := cycleState.Read(placementStateKey(nodeName))
+	if err != nil {
+		return nil
+	}
+	state, ok := data.(*placementState)
+	if !ok {
+		return nil
+	}
+	return state.zones
+}
+
+func zoneNamesFromNUMAIDs(zones topologyv1alpha2.ZoneList, numaIDs []int) []string {
+	var zoneNames []string
+	for _, zone := range zones {
+		if zone.Type != "Node" {
+			continue
+		}
+		numaID, err := numanode.NameToID(zone.Name)
+		if err != nil || !slices.Contains(numaIDs, numaID) {
+			continue
+		}
+		zoneNames = append(zoneNames, zone.Name)
+	}
+	return zoneNames
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package noderesourcetopology
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	topologyv1alpha2 "github.com/k8stopologyawareschedwg/noderesourcetopology-api/pkg/apis/topology/v1alpha2"
+
+	nrtcache "sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology/cache"
+	tu "sigs.k8s.io/scheduler-plugins/test/util"
+)
+
+func TestFilterPlacementState(t *testing.T) {
+	tcases := []struct {
+		description   string
+		policy        topologyv1alpha2.TopologyManagerPolicy
+		zones         topologyv1alpha2.ZoneList
+		pod           *v1.Pod
+		expectedZones []string
+	}{
+		{
+			description:   "pod scope, guaranteed pod",
+			policy:        topologyv1alpha2.SingleNUMANodePodLevel,
+			zones:         makeRestrictedZones("1", "4"),
+			pod:           makePodByResourceList(ptrTo(cpuMemRequest("2", "1Gi"))),
+			expectedZones: []string{"node-1"},
+		},
+		{
+			description: "pod scope, burstable pod",
+			policy:      topologyv1alpha2.SingleNUMANodePodLevel,
+			zones:       makeRestrictedZones("1", "4"),
+			pod:         makePodWithReqByResourceList(ptrTo(cpuMemRequest("2", "1Gi"))),
+		},
+		{
+			description:   "container scope, containers on the same zone",
+			policy:        topologyv1alpha2.SingleNUMANodeContainerLevel,
+			zones:         makeRestrictedZones("4", "1"),
+			pod:           makePodByResourceLists(cpuMemRequest("2", "1Gi"), cpuMemRequest("2", "1Gi")),
+			expectedZones: []string{"node-0"},
+		},
+		{
+			description:   "container scope, containers on different zones",
+			policy:        topologyv1alpha2.SingleNUMANodeContainerLevel,
+			zones:         makeRestrictedZones("3", "4"),
+			pod:           makePodByResourceLists(cpuMemRequest("4", "1Gi"), cpuMemRequest("3", "1Gi")),
+			expectedZones: []string{"node-0", "node-1"},
+		},
+		{
+			description: "pod scope, pod fitting on several zones",
+			policy:      topologyv1alpha2.SingleNUMANodePodLevel,
+			zones:       makeRestrictedZones("4", "4"),
+			pod:         makePodByResourceList(ptrTo(cpuMemRequest("2", "1Gi"))),
+		},
+		{
+			description: "container scope, container fitting on several zones",
+			policy:      topologyv1alpha2.SingleNUMANodeContainerLevel,
+			zones:       makeRestrictedZones("4", "2"),
+			pod:         makePodByResourceLists(cpuMemRequest("2", "1Gi"), cpuMemRequest("2", "1Gi")),
+		},
+		{
+			description: "restricted policy",
+			policy:      topologyv1alpha2.RestrictedPodLevel,
+			zones:       makeRestrictedZones("1", "4"),
+			pod:         makePodByResourceList(ptrTo(cpuMemRequest("2", "1Gi"))),
+		},
+	}
+
+	for _, tc := range tcases {
+		t.Run(tc.description, func(t *testing.T) {
+			nrt := &topologyv1alpha2.NodeResourceTopology{
+				ObjectMeta:       metav1.ObjectMeta{Name: "node"},
+				TopologyPolicies: []string{string(tc.policy)},
+				Zones:            tc.zones,
+			}
+			fakeClient, err := tu.NewFakeClient(nrt)
+			if err != nil {
+				t.Fatalf("failed to create fake client: %v", err)
+			}
+			tm := TopologyMatch{
+				nrtCache: nrtcache.NewPassthrough(klog.Background(), fakeClient),
+			}
+
+			cycleState := framework.NewCycleState()
+			nodeInfo := framework.NewNodeInfo()
+			nodeInfo.SetNode(makeNodeFromNodeResourceTopology(nrt))
+			if status := tm.Filter(context.Background(), cycleState, tc.pod, nodeInfo); status != nil {
+				t.Fatalf("unexpected status: %v", status)
+			}
+
+			zones := readPlacementZones(cycleState, "node")
+			if !reflect.DeepEqual(zones, tc.expectedZones) {
+				t.Errorf("expected placement zones %v got %v", tc.expectedZones, zones)
+			}
+			if zones := readPlacementZones(cycleState, "other-node"); zones != nil {
+				t.Errorf("unexpected placement zones %v on another node", zones)
+			}
+		})
+	}
+}
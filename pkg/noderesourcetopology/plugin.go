@@ -19,6 +19,8 @@ package noderesourcetopology
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sync"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -29,6 +31,8 @@ import (
 
 	apiconfig "sigs.k8s.io/scheduler-plugins/apis/config"
 	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
+	"sigs.k8s.io/scheduler-plugins/pkg/debug"
+	"sigs.k8s.io/scheduler-plugins/pkg/metrics"
 	nrtcache "sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology/cache"
 
 	"github.com/go-logr/logr"
@@ -43,6 +47,33 @@ const (
 
 var scheme = runtime.NewScheme()
 
+// profileCaches holds the NRT cache of the plugin of each profile, keyed by the framework handle of
+// the profile. The framework gives no access to the plugins it runs, so the other plugins of the
+// profile can only get the cache through ProfileCache.
+var profileCaches sync.Map
+
+// ProfileCache returns the NRT cache of the NodeResourceTopologyMatch plugin of the profile of the
+// given handle, which accounts the resources the plugin reserved, if the profile enables the plugin.
+// The plugins of a profile are created in no particular order, so the cache must be looked up when
+// consumed rather than when the consuming plugin is created.
+func ProfileCache(handle framework.Handle) (nrtcache.Interface, bool) {
+	nrtCache, ok := profileCaches.Load(handle)
+	if !ok {
+		return nil, false
+	}
+	return nrtCache.(nrtcache.Interface), true
+}
+
+// registerProfileCache makes the NRT cache of the plugin available through ProfileCache until the
+// plugin stops along with its framework.
+func registerProfileCache(ctx context.Context, handle framework.Handle, nrtCache nrtcache.Interface) {
+	profileCaches.Store(handle, nrtCache)
+	go func() {
+		<-ctx.Done()
+		profileCaches.Delete(handle)
+	}()
+}
+
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(topologyv1alpha2.AddToScheme(scheme))
@@ -57,6 +88,7 @@ type TopologyMatch struct {
 	nrtCache            nrtcache.Interface
 	scoreStrategyFunc   scoreStrategyFn
 	scoreStrategyType   apiconfig.ScoringStrategyType
+	distanceWeighted    bool
 }
 
 var _ framework.FilterPlugin = &TopologyMatch{}
@@ -64,12 +96,40 @@ var _ framework.ReservePlugin = &TopologyMatch{}
 var _ framework.ScorePlugin = &TopologyMatch{}
 var _ framework.EnqueueExtensions = &TopologyMatch{}
 var _ framework.PostBindPlugin = &TopologyMatch{}
+var _ debug.StateDumper = &TopologyMatch{}
+var _ debug.HandlerProvider = &TopologyMatch{}
 
 // Name returns name of the plugin. It is used in logs, etc.
 func (tm *TopologyMatch) Name() string {
 	return Name
 }
 
+// DumpState records the state of the NRT cache. Only the overreserve cache has any state of its own.
+func (tm *TopologyMatch) DumpState(state *debug.ProfileState) {
+	if dumper, ok := tm.nrtCache.(interface {
+		DumpState() *debug.NodeResourceTopologyState
+	}); ok {
+		state.NodeResourceTopology = dumper.DumpState()
+	}
+}
+
+// DebugHandlers serves the state of the NRT cache and the nodes it is going to resync, if the
+// cache has any state of its own.
+func (tm *TopologyMatch) DebugHandlers() map[string]http.Handler {
+	handlers := make(map[string]http.Handler)
+	if dumper, ok := tm.nrtCache.(interface {
+		DumpState() *debug.NodeResourceTopologyState
+	}); ok {
+		handlers["cache"] = debug.JSONHandler(func() interface{} { return dumper.DumpState() })
+	}
+	if dumper, ok := tm.nrtCache.(interface {
+		DumpDesyncState() *debug.NodeResourceTopologyDesyncState
+	}); ok {
+		handlers["desynced"] = debug.JSONHandler(func() interface{} { return dumper.DumpDesyncState() })
+	}
+	return handlers
+}
+
 // New initializes a new plugin and returns it.
 func New(ctx context.Context, args runtime.Object, handle framework.Handle) (framework.Plugin, error) {
 	lh := klog.FromContext(ctx)
@@ -83,12 +143,15 @@ func New(ctx context.Context, args runtime.Object, handle framework.Handle) (fra
 	if err := validation.ValidateNodeResourceTopologyMatchArgs(nil, tcfg); err != nil {
 		return nil, err
 	}
+	metrics.Register()
 
 	nrtCache, err := initNodeTopologyInformer(ctx, lh, tcfg, handle)
 	if err != nil {
 		lh.Error(err, "cannot create clientset for NodeTopologyResource", "kubeConfig", handle.KubeConfig())
 		return nil, err
 	}
+	// share the cache with the GangChecker of the profile, so that it sees the reserved resources
+	registerProfileCache(ctx, handle, nrtCache)
 
 	resToWeightMap := make(resourceToWeightMap)
 	for _, resource := range tcfg.ScoringStrategy.Resources {
@@ -110,6 +173,7 @@ func New(ctx context.Context, args runtime.Object, handle framework.Handle) (fra
 		nrtCache:            nrtCache,
 		scoreStrategyFunc:   strategy,
 		scoreStrategyType:   tcfg.ScoringStrategy.Type,
+		distanceWeighted:    tcfg.ScoringStrategy.DistanceWeighted,
 	}
 
 	return topologyMatch, nil
@@ -58,7 +58,11 @@ func initNodeTopologyInformer(ctx context.Context, lh logr.Logger,
 		return nrtcache.NewPassthrough(lh.WithName(logging.SubsystemNRTCache), client), nil
 	}
 
-	podSharedInformer, podLister, isPodRelevant := podprovider.NewFromHandle(lh, handle, tcfg.Cache)
+	podSharedInformer, podLister, isPodRelevant, err := podprovider.NewFromHandle(lh, handle, tcfg.Cache)
+	if err != nil {
+		lh.Error(err, "cannot index the pods by node")
+		return nil, err
+	}
 
 	nrtCache, err := nrtcache.NewOverReserve(ctx, lh.WithName(logging.SubsystemNRTCache), tcfg.Cache, client, podLister, isPodRelevant)
 	if err != nil {
@@ -18,6 +18,7 @@ package podprovider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -34,15 +35,68 @@ import (
 
 type PodFilterFunc func(lh logr.Logger, pod *corev1.Pod) bool
 
-func NewFromHandle(lh logr.Logger, handle framework.Handle, cacheConf *apiconfig.NodeResourceTopologyCache) (k8scache.SharedIndexInformer, podlisterv1.PodLister, PodFilterFunc) {
+// NodeNameIndex is the name of the index of the pod informers by the node the pods are bound to.
+const NodeNameIndex = "spec.nodeName"
+
+// PodLister lists pods, and can list the pods bound to a node without listing all of them.
+type PodLister interface {
+	podlisterv1.PodLister
+	// ListByNode lists the pods bound to the given node.
+	ListByNode(nodeName string) ([]*corev1.Pod, error)
+}
+
+type indexedPodLister struct {
+	podlisterv1.PodLister
+	indexer k8scache.Indexer
+}
+
+func (l indexedPodLister) ListByNode(nodeName string) ([]*corev1.Pod, error) {
+	objs, err := l.indexer.ByIndex(NodeNameIndex, nodeName)
+	if err != nil {
+		return nil, err
+	}
+	pods := make([]*corev1.Pod, 0, len(objs))
+	for _, obj := range objs {
+		pod, ok := obj.(*corev1.Pod)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object type %T in the pod indexer", obj)
+		}
+		pods = append(pods, pod)
+	}
+	return pods, nil
+}
+
+func indexByNodeName(obj interface{}) ([]string, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok || pod.Spec.NodeName == "" {
+		return nil, nil
+	}
+	return []string{pod.Spec.NodeName}, nil
+}
+
+// NewPodLister returns a PodLister backed by the given indexer, which must be indexed by NodeNameIndex.
+func NewPodLister(indexer k8scache.Indexer) PodLister {
+	return indexedPodLister{
+		PodLister: podlisterv1.NewPodLister(indexer),
+		indexer:   indexer,
+	}
+}
+
+func NewFromHandle(lh logr.Logger, handle framework.Handle, cacheConf *apiconfig.NodeResourceTopologyCache) (k8scache.SharedIndexInformer, PodLister, PodFilterFunc, error) {
 	dedicated := wantsDedicatedInformer(cacheConf)
 	if !dedicated {
-		podHandle := handle.SharedInformerFactory().Core().V1().Pods() // shortcut
-		return podHandle.Informer(), podHandle.Lister(), IsPodRelevantShared
+		podInformer := handle.SharedInformerFactory().Core().V1().Pods().Informer()
+		// the informer is shared by all the profiles, and only the first one adds the index
+		if _, ok := podInformer.GetIndexer().GetIndexers()[NodeNameIndex]; !ok {
+			if err := podInformer.AddIndexers(k8scache.Indexers{NodeNameIndex: indexByNodeName}); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+		return podInformer, NewPodLister(podInformer.GetIndexer()), IsPodRelevantShared, nil
 	}
 
-	podInformer := coreinformers.NewFilteredPodInformer(handle.ClientSet(), metav1.NamespaceAll, 0, cache.Indexers{}, nil)
-	podLister := podlisterv1.NewPodLister(podInformer.GetIndexer())
+	podInformer := coreinformers.NewFilteredPodInformer(handle.ClientSet(), metav1.NamespaceAll, 0, cache.Indexers{NodeNameIndex: indexByNodeName}, nil)
+	podLister := NewPodLister(podInformer.GetIndexer())
 
 	lh.V(5).Info("start custom pod informer")
 	ctx := context.Background()
@@ -52,7 +106,7 @@ func NewFromHandle(lh logr.Logger, handle framework.Handle, cacheConf *apiconfig
 	cache.WaitForCacheSync(ctx.Done(), podInformer.HasSynced)
 	lh.V(5).Info("synced custom pod informer")
 
-	return podInformer, podLister, IsPodRelevantDedicated
+	return podInformer, podLister, IsPodRelevantDedicated, nil
 }
 
 // IsPodRelevantAlways is meant to be used in test only
@@ -31,7 +31,8 @@ func (tm *TopologyMatch) Reserve(ctx context.Context, state *framework.CycleStat
 	lh.V(4).Info(logging.FlowBegin)
 	defer lh.V(4).Info(logging.FlowEnd)
 
-	tm.nrtCache.ReserveNodeResources(nodeName, pod)
+	// the zones are known only if Filter could predict the placement, otherwise the accounting is pessimistic
+	tm.nrtCache.ReserveNodeResources(nodeName, pod, readPlacementZones(state, nodeName)...)
 	// can't fail
 	return framework.NewStatus(framework.Success, "")
 }
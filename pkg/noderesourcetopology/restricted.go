@@ -0,0 +1,335 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package noderesourcetopology
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1qos "k8s.io/kubernetes/pkg/apis/core/v1/helper/qos"
+	bm "k8s.io/kubernetes/pkg/kubelet/cm/topologymanager/bitmask"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"github.com/go-logr/logr"
+	topologyv1alpha2 "github.com/k8stopologyawareschedwg/noderesourcetopology-api/pkg/apis/topology/v1alpha2"
+	"github.com/k8stopologyawareschedwg/noderesourcetopology-api/pkg/apis/topology/v1alpha2/helper/numanode"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology/logging"
+	"sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology/nodeconfig"
+	"sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology/stringify"
+	"sigs.k8s.io/scheduler-plugins/pkg/util"
+)
+
+// The restricted policy admits a pod only if the merged hint of all the hint providers is preferred.
+// Hint providers (CPU, memory and device managers) generate, for each resource, one hint per NUMA node
+// combination able to satisfy the request, flagging as preferred the combinations with the minimal
+// amount of NUMA nodes which could ever satisfy the request (computed against allocatable, not available).
+// The merged hint is preferred only if all the merged hints are preferred, so we only need to consider
+// preferred hints to tell if the kubelet will admit the pod, and which NUMA nodes it will pick.
+// https://github.com/kubernetes/kubernetes/blob/v1.31.2/pkg/kubelet/cm/topologymanager/policy_restricted.go
+
+func restrictedContainerLevelHandler(conf nodeconfig.TopologyManager) filterFn {
+	return func(lh logr.Logger, pod *v1.Pod, zones topologyv1alpha2.ZoneList, nodeInfo *framework.NodeInfo) *framework.Status {
+		lh.V(5).Info("container level restricted handler")
+
+		nodes := createNUMANodeList(lh, zones)
+		if status := checkMaxNUMANodes(lh, conf, nodes); status != nil {
+			return status
+		}
+		allocatable := createNUMAAllocatableMap(lh, zones)
+		qos := v1qos.GetPodQOS(pod)
+
+		// Node() != nil already verified in Filter(), which is the only public entry point
+		logNumaNodes(lh, "container handler NUMA resources", nodeInfo.Node().Name, nodes)
+
+		// like in singleNUMAContainerLevelHandler, init containers run serially and before the app containers,
+		// so we don't need to accumulate their resources together.
+		for _, initContainer := range pod.Spec.InitContainers {
+			clh := lh.WithValues(logging.KeyContainer, initContainer.Name, logging.KeyContainerKind, logging.KindContainerInit)
+			clh.V(6).Info("desired resources", stringify.ResourceListToLoggable(initContainer.Resources.Requests)...)
+
+			_, match := restrictedAlignment(clh, conf, nodes, allocatable, initContainer.Resources.Requests, qos, nodeInfo)
+			if !match {
+				clh.V(2).Info("cannot align container")
+				return framework.NewStatus(framework.Unschedulable, "cannot align init container")
+			}
+		}
+
+		for _, container := range pod.Spec.Containers {
+			clh := lh.WithValues(logging.KeyContainer, container.Name, logging.KeyContainerKind, logging.KindContainerApp)
+			clh.V(6).Info("container requests", stringify.ResourceListToLoggable(container.Resources.Requests)...)
+
+			affinity, match := restrictedAlignment(clh, conf, nodes, allocatable, container.Resources.Requests, qos, nodeInfo)
+			if !match {
+				clh.V(2).Info("cannot align container")
+				return framework.NewStatus(framework.Unschedulable, "cannot align container")
+			}
+
+			// subtract the resources requested by the container from the selected NUMA nodes.
+			// this is necessary, so we won't allocate the same resources for the upcoming containers
+			subtractFromNUMAs(alignedRequests(qos, container.Resources.Requests), nodes, numaIDsToIndexes(nodes, affinity.GetBits())...)
+			clh.V(4).Info("container aligned", "numaCells", affinity.String())
+		}
+		return nil
+	}
+}
+
+func restrictedPodLevelHandler(conf nodeconfig.TopologyManager) filterFn {
+	return func(lh logr.Logger, pod *v1.Pod, zones topologyv1alpha2.ZoneList, nodeInfo *framework.NodeInfo) *framework.Status {
+		lh.V(5).Info("pod level restricted handler")
+
+		resources := util.GetPodEffectiveRequest(pod)
+
+		nodes := createNUMANodeList(lh, zones)
+		if status := checkMaxNUMANodes(lh, conf, nodes); status != nil {
+			return status
+		}
+
+		// Node() != nil already verified in Filter(), which is the only public entry point
+		logNumaNodes(lh, "pod handler NUMA resources", nodeInfo.Node().Name, nodes)
+		lh.V(6).Info("pod desired resources", stringify.ResourceListToLoggable(resources)...)
+
+		affinity, match := restrictedAlignment(lh, conf, nodes, createNUMAAllocatableMap(lh, zones), resources, v1qos.GetPodQOS(pod), nodeInfo)
+		if !match {
+			lh.V(2).Info("cannot align pod", "name", pod.Name)
+			return framework.NewStatus(framework.Unschedulable, "cannot align pod")
+		}
+		lh.V(4).Info("all container placed", "numaCells", affinity.String())
+		return nil
+	}
+}
+
+// checkMaxNUMANodes rejects nodes with more NUMA nodes than the Topology Manager supports;
+// the kubelet refuses to start on them.
+func checkMaxNUMANodes(lh logr.Logger, conf nodeconfig.TopologyManager, nodes NUMANodeList) *framework.Status {
+	if len(nodes) <= conf.MaxNUMANodes() {
+		return nil
+	}
+	lh.V(2).Info("too many NUMA nodes for the topology manager", "numaCells", len(nodes), "max", conf.MaxNUMANodes())
+	return framework.NewStatus(framework.UnschedulableAndUnresolvable, "too many NUMA nodes for the topology manager")
+}
+
+// restrictedAlignment returns the NUMA affinity the kubelet would pick for the given resources, and false
+// if the kubelet would reject them. A nil affinity means none of the resources requires NUMA alignment.
+func restrictedAlignment(lh logr.Logger, conf nodeconfig.TopologyManager, nodes NUMANodeList, allocatable map[int]v1.ResourceList, resources v1.ResourceList, qos v1.PodQOSClass, nodeInfo *framework.NodeInfo) (bm.BitMask, bool) {
+	numaIDs := make([]int, 0, len(nodes))
+	for _, node := range nodes {
+		numaIDs = append(numaIDs, node.NUMAID)
+	}
+
+	nodeResources := util.ResourceList(nodeInfo.Allocatable)
+
+	// nil means no preference, which is what the kubelet assumes when no provider returns hints.
+	merged := []bm.BitMask{nil}
+	for resource, quantity := range resources {
+		if quantity.IsZero() {
+			lh.V(4).Info("ignoring zero-qty resource request", "resource", resource)
+			continue
+		}
+
+		if _, ok := nodeResources[resource]; !ok {
+			// see resourcesAvailableInAnyNUMANodes for the rationale
+			lh.V(2).Info("early verdict: cannot meet request", "resource", resource, "suitable", "false")
+			return nil, false
+		}
+
+		if qos != v1.PodQOSGuaranteed && isNUMAAffineResource(resource) {
+			// the CPU and memory managers provide hints only for guaranteed pods
+			lh.V(4).Info("ignoring QoS-depending exclusive request", "resource", resource, "QoS", qos)
+			continue
+		}
+
+		if !resourceHasNUMAAffinity(nodes, resource) {
+			// non-native resources or ephemeral-storage may not expose NUMA affinity,
+			// but since they are available at node level, this is fine
+			if isHostLevelResource(resource) {
+				lh.V(6).Info("resource available at host level (no NUMA affinity)", "resource", resource)
+				continue
+			}
+			lh.V(2).Info("early verdict: resource not available on any NUMA node", "resource", resource, "suitable", "false")
+			return nil, false
+		}
+
+		hints := preferredHints(nodes, allocatable, numaIDs, resource, quantity)
+		if len(hints) == 0 {
+			lh.V(2).Info("early verdict: no preferred NUMA affinity", "resource", resource, "suitable", "false")
+			return nil, false
+		}
+		lh.V(6).Info("preferred hints", "resource", resource, "count", len(hints))
+
+		merged = mergeHints(merged, hints)
+		if len(merged) == 0 {
+			lh.V(2).Info("early verdict: cannot merge NUMA affinities", "resource", resource, "suitable", "false")
+			return nil, false
+		}
+	}
+
+	best := merged[0]
+	for _, candidate := range merged[1:] {
+		best = bestHint(nodes, conf.PreferClosestNUMA, best, candidate)
+	}
+	if best == nil {
+		// no resource requires alignment: the kubelet falls back to all the NUMA nodes
+		best, _ = bm.NewBitMask(numaIDs...)
+	}
+	lh.V(2).Info("final verdict", "suitable", true, "numaCells", best.String())
+	return best, true
+}
+
+// preferredHints returns all the NUMA affinities with minimal size which can fit the requested quantity.
+func preferredHints(nodes NUMANodeList, allocatable map[int]v1.ResourceList, numaIDs []int, resourceName v1.ResourceName, quantity resource.Quantity) []bm.BitMask {
+	minAffinitySize := len(numaIDs) + 1
+	bm.IterateBitMasks(numaIDs, func(mask bm.BitMask) {
+		if mask.Count() >= minAffinitySize {
+			return
+		}
+		total := sumQuantity(mask, resourceName, allocatable)
+		if total.Cmp(quantity) >= 0 {
+			minAffinitySize = mask.Count()
+		}
+	})
+
+	available := make(map[int]v1.ResourceList)
+	for _, node := range nodes {
+		available[node.NUMAID] = node.Resources
+	}
+
+	var hints []bm.BitMask
+	bm.IterateBitMasks(numaIDs, func(mask bm.BitMask) {
+		if mask.Count() != minAffinitySize {
+			return
+		}
+		total := sumQuantity(mask, resourceName, available)
+		if total.Cmp(quantity) >= 0 {
+			hints = append(hints, mask)
+		}
+	})
+	return hints
+}
+
+// mergeHints returns all the distinct non-empty intersections between the current affinities and the hints
+func mergeHints(current, hints []bm.BitMask) []bm.BitMask {
+	seen := make(map[string]bool)
+	var merged []bm.BitMask
+	for _, cur := range current {
+		for _, hint := range hints {
+			mask := hint
+			if cur != nil {
+				mask = bm.And(cur, hint)
+			}
+			if mask.IsEmpty() || seen[mask.String()] {
+				continue
+			}
+			seen[mask.String()] = true
+			merged = append(merged, mask)
+		}
+	}
+	return merged
+}
+
+// bestHint mimics the kubelet hint merger comparison, including the prefer-closest-numa-nodes option
+func bestHint(nodes NUMANodeList, preferClosest bool, current, candidate bm.BitMask) bm.BitMask {
+	if current.IsEqual(candidate) {
+		return current
+	}
+	if preferClosest && current.Count() == candidate.Count() {
+		curDistance := nodesAvgDistance(logr.Discard(), nodes, numaIDsToIndexes(nodes, current.GetBits())...)
+		candDistance := nodesAvgDistance(logr.Discard(), nodes, numaIDsToIndexes(nodes, candidate.GetBits())...)
+		if curDistance != candDistance {
+			if candDistance < curDistance {
+				return candidate
+			}
+			return current
+		}
+	}
+	if candidate.IsNarrowerThan(current) {
+		return candidate
+	}
+	return current
+}
+
+func sumQuantity(mask bm.BitMask, resourceName v1.ResourceName, resourcesPerNUMA map[int]v1.ResourceList) resource.Quantity {
+	total := resource.Quantity{}
+	for _, numaID := range mask.GetBits() {
+		if qty, ok := resourcesPerNUMA[numaID][resourceName]; ok {
+			total.Add(qty)
+		}
+	}
+	return total
+}
+
+func resourceHasNUMAAffinity(nodes NUMANodeList, resource v1.ResourceName) bool {
+	for _, node := range nodes {
+		if _, ok := node.Resources[resource]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// alignedRequests returns the requests which are going to consume NUMA-local resources
+func alignedRequests(qos v1.PodQOSClass, requests v1.ResourceList) v1.ResourceList {
+	if qos == v1.PodQOSGuaranteed {
+		return requests
+	}
+	ret := v1.ResourceList{}
+	for resName, qty := range requests {
+		if isNUMAAffineResource(resName) {
+			continue
+		}
+		ret[resName] = qty
+	}
+	return ret
+}
+
+func numaIDsToIndexes(nodes NUMANodeList, numaIDs []int) []int {
+	indexes := make([]int, 0, len(numaIDs))
+	for _, numaID := range numaIDs {
+		for idx, node := range nodes {
+			if node.NUMAID == numaID {
+				indexes = append(indexes, idx)
+				break
+			}
+		}
+	}
+	return indexes
+}
+
+// createNUMAAllocatableMap returns the allocatable resources per NUMA ID. The kubelet computes the preferred
+// NUMA affinities against allocatable resources, regardless of their current availability.
+func createNUMAAllocatableMap(lh logr.Logger, zones topologyv1alpha2.ZoneList) map[int]v1.ResourceList {
+	allocatable := make(map[int]v1.ResourceList)
+	for _, zone := range zones {
+		if zone.Type != "Node" {
+			continue
+		}
+		numaID, err := numanode.NameToID(zone.Name)
+		if err != nil || numaID > maxNUMAId {
+			lh.Error(err, "error getting the numaID", "zone", zone.Name, "numaID", numaID)
+			continue
+		}
+		res := make(v1.ResourceList)
+		for _, resInfo := range zone.Resources {
+			qty := resInfo.Allocatable
+			if qty.IsZero() {
+				qty = resInfo.Capacity
+			}
+			res[v1.ResourceName(resInfo.Name)] = qty.DeepCopy()
+		}
+		allocatable[numaID] = res
+	}
+	return allocatable
+}
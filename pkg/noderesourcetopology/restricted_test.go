@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package noderesourcetopology
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	topologyv1alpha2 "github.com/k8stopologyawareschedwg/noderesourcetopology-api/pkg/apis/topology/v1alpha2"
+
+	"sigs.k8s.io/scheduler-plugins/pkg/noderesourcetopology/nodeconfig"
+)
+
+// makeRestrictedZones creates NUMA zones with 4 CPUs and 4Gi of memory allocatable each,
+// and the given amount of available CPUs.
+func makeRestrictedZones(availableCPUs ...string) topologyv1alpha2.ZoneList {
+	zones := topologyv1alpha2.ZoneList{}
+	for idx, availableCPU := range availableCPUs {
+		zones = append(zones, topologyv1alpha2.Zone{
+			Name: fmt.Sprintf("node-%d", idx),
+			Type: "Node",
+			Resources: topologyv1alpha2.ResourceInfoList{
+				MakeTopologyResInfo(cpu, "4", availableCPU),
+				MakeTopologyResInfo(memory, "4Gi", "4Gi"),
+			},
+		})
+	}
+	return zones
+}
+
+func makeNodeInfoFromZones(zones topologyv1alpha2.ZoneList) *framework.NodeInfo {
+	nodeInfo := framework.NewNodeInfo()
+	nodeInfo.SetNode(makeNodeFromNodeResourceTopology(&topologyv1alpha2.NodeResourceTopology{
+		ObjectMeta: metav1.ObjectMeta{Name: "node"},
+		Zones:      zones,
+	}))
+	return nodeInfo
+}
+
+func cpuMemRequest(cpuQty, memQty string) v1.ResourceList {
+	return v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse(cpuQty),
+		v1.ResourceMemory: resource.MustParse(memQty),
+	}
+}
+
+func TestRestrictedHandlers(t *testing.T) {
+	tcases := []struct {
+		description string
+		scope       string
+		zones       topologyv1alpha2.ZoneList
+		pod         *v1.Pod
+		wantStatus  *framework.Status
+	}{
+		{
+			description: "pod scope, fits in a single NUMA node",
+			scope:       kubeletconfig.PodTopologyManagerScope,
+			zones:       makeRestrictedZones("4", "4"),
+			pod:         makePodByResourceList(ptrTo(cpuMemRequest("2", "1Gi"))),
+		},
+		{
+			description: "pod scope, needs and gets two NUMA nodes",
+			scope:       kubeletconfig.PodTopologyManagerScope,
+			zones:       makeRestrictedZones("4", "4"),
+			pod:         makePodByResourceList(ptrTo(cpuMemRequest("6", "1Gi"))),
+		},
+		{
+			description: "pod scope, fits in a single NUMA node but only one has enough free CPUs",
+			scope:       kubeletconfig.PodTopologyManagerScope,
+			zones:       makeRestrictedZones("1", "4"),
+			pod:         makePodByResourceList(ptrTo(cpuMemRequest("3", "1Gi"))),
+		},
+		{
+			description: "pod scope, could fit in a single NUMA node but fragmented",
+			scope:       kubeletconfig.PodTopologyManagerScope,
+			zones:       makeRestrictedZones("2", "2"),
+			pod:         makePodByResourceList(ptrTo(cpuMemRequest("3", "1Gi"))),
+			wantStatus:  framework.NewStatus(framework.Unschedulable, "cannot align pod"),
+		},
+		{
+			description: "pod scope, burstable pod gets no CPU alignment",
+			scope:       kubeletconfig.PodTopologyManagerScope,
+			zones:       makeRestrictedZones("2", "2"),
+			pod:         makePodWithReqByResourceList(ptrTo(cpuMemRequest("3", "1Gi"))),
+		},
+		{
+			description: "pod scope, CPU and memory available on different NUMA nodes",
+			scope:       kubeletconfig.PodTopologyManagerScope,
+			zones: topologyv1alpha2.ZoneList{
+				{
+					Name: "node-0",
+					Type: "Node",
+					Resources: topologyv1alpha2.ResourceInfoList{
+						MakeTopologyResInfo(cpu, "4", "4"),
+						MakeTopologyResInfo(memory, "4Gi", "512Mi"),
+					},
+				},
+				{
+					Name: "node-1",
+					Type: "Node",
+					Resources: topologyv1alpha2.ResourceInfoList{
+						MakeTopologyResInfo(cpu, "4", "1"),
+						MakeTopologyResInfo(memory, "4Gi", "4Gi"),
+					},
+				},
+			},
+			pod:        makePodByResourceList(ptrTo(cpuMemRequest("3", "1Gi"))),
+			wantStatus: framework.NewStatus(framework.Unschedulable, "cannot align pod"),
+		},
+		{
+			description: "container scope, containers spread over NUMA nodes",
+			scope:       kubeletconfig.ContainerTopologyManagerScope,
+			zones:       makeRestrictedZones("4", "4"),
+			pod:         makePodByResourceLists(cpuMemRequest("3", "1Gi"), cpuMemRequest("3", "1Gi")),
+		},
+		{
+			description: "container scope, last container cannot be aligned",
+			scope:       kubeletconfig.ContainerTopologyManagerScope,
+			zones:       makeRestrictedZones("4", "4"),
+			pod:         makePodByResourceLists(cpuMemRequest("3", "1Gi"), cpuMemRequest("3", "1Gi"), cpuMemRequest("2", "1Gi")),
+			wantStatus:  framework.NewStatus(framework.Unschedulable, "cannot align container"),
+		},
+		{
+			description: "container scope, init container cannot be aligned",
+			scope:       kubeletconfig.ContainerTopologyManagerScope,
+			zones:       makeRestrictedZones("2", "2"),
+			pod: makePod("init", withMultiInitContainers([]v1.ResourceList{cpuMemRequest("3", "1Gi")}),
+				withMultiContainers([]v1.ResourceList{cpuMemRequest("1", "1Gi")})),
+			wantStatus: framework.NewStatus(framework.Unschedulable, "cannot align init container"),
+		},
+		{
+			description: "too many NUMA nodes",
+			scope:       kubeletconfig.PodTopologyManagerScope,
+			zones:       makeRestrictedZones("4", "4", "4", "4", "4", "4", "4", "4", "4"),
+			pod:         makePodByResourceList(ptrTo(cpuMemRequest("2", "1Gi"))),
+			wantStatus:  framework.NewStatus(framework.UnschedulableAndUnresolvable, "too many NUMA nodes for the topology manager"),
+		},
+	}
+
+	for _, tc := range tcases {
+		t.Run(tc.description, func(t *testing.T) {
+			conf := nodeconfig.TopologyManager{
+				Policy: kubeletconfig.RestrictedTopologyManagerPolicy,
+				Scope:  tc.scope,
+			}
+			handler := filterHandlerFromTopologyManager(conf)
+			if handler == nil {
+				t.Fatalf("missing handler for %s", conf.String())
+			}
+			gotStatus := handler(klog.Background(), tc.pod, tc.zones, makeNodeInfoFromZones(tc.zones))
+			if !reflect.DeepEqual(gotStatus, tc.wantStatus) {
+				t.Errorf("status does not match: %v, want: %v", gotStatus, tc.wantStatus)
+			}
+		})
+	}
+}
+
+func TestRestrictedAlignmentPolicyOptions(t *testing.T) {
+	// the pod can only fit on a pair of NUMA nodes not including node-1
+	zones := makeZonesWithCosts(twoSocketsDistances, "4", "1", "4", "4")
+	nodes := createNUMANodeList(klog.Background(), zones)
+	allocatable := createNUMAAllocatableMap(klog.Background(), zones)
+	nodeInfo := makeNodeInfoFromZones(zones)
+
+	tcases := []struct {
+		description string
+		conf        nodeconfig.TopologyManager
+		expected    []int
+	}{
+		{
+			description: "default picks the lowest NUMA nodes",
+			conf:        nodeconfig.TopologyManager{},
+			expected:    []int{0, 2},
+		},
+		{
+			description: "prefer-closest-numa-nodes picks the NUMA nodes on the same socket",
+			conf:        nodeconfig.TopologyManager{PreferClosestNUMA: true},
+			expected:    []int{2, 3},
+		},
+	}
+	for _, tc := range tcases {
+		t.Run(tc.description, func(t *testing.T) {
+			// CPUs only: memory would fit in a single NUMA node, narrowing the merged affinity to it
+			cpus := v1.ResourceList{v1.ResourceCPU: resource.MustParse("6")}
+			affinity, ok := restrictedAlignment(klog.Background(), tc.conf, nodes, allocatable, cpus, v1.PodQOSGuaranteed, nodeInfo)
+			if !ok {
+				t.Fatalf("expected the resources to be aligned")
+			}
+			if !reflect.DeepEqual(affinity.GetBits(), tc.expected) {
+				t.Errorf("expected NUMA affinity %v got %v", tc.expected, affinity.GetBits())
+			}
+		})
+	}
+}
+
+func TestRestrictedMaxAllowableNUMANodes(t *testing.T) {
+	zones := makeRestrictedZones("4", "4", "4", "4", "4", "4", "4", "4", "4", "4")
+	conf := nodeconfig.TopologyManager{
+		Policy:                kubeletconfig.RestrictedTopologyManagerPolicy,
+		Scope:                 kubeletconfig.PodTopologyManagerScope,
+		MaxAllowableNUMANodes: 16,
+	}
+	status := filterHandlerFromTopologyManager(conf)(klog.Background(), makePodByResourceList(ptrTo(cpuMemRequest("2", "1Gi"))), zones, makeNodeInfoFromZones(zones))
+	if status != nil {
+		t.Errorf("unexpected status: %v", status)
+	}
+}
+
+func TestFilterHandlerBestEffort(t *testing.T) {
+	for _, scope := range []string{kubeletconfig.PodTopologyManagerScope, kubeletconfig.ContainerTopologyManagerScope} {
+		conf := nodeconfig.TopologyManager{
+			Policy: kubeletconfig.BestEffortTopologyManagerPolicy,
+			Scope:  scope,
+		}
+		if handler := filterHandlerFromTopologyManager(conf); handler != nil {
+			t.Errorf("unexpected handler for %s", conf.String())
+		}
+	}
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
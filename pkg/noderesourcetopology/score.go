@@ -169,6 +169,10 @@ func (tm *TopologyMatch) scoringHandlerFromTopologyManagerConfig(conf nodeconfig
 		}
 		return nil // cannot happen
 	}
+	if tm.distanceWeighted && (conf.Policy == kubeletconfig.RestrictedTopologyManagerPolicy || conf.Policy == kubeletconfig.BestEffortTopologyManagerPolicy) {
+		return tm.distanceWeightedScoringHandler(conf)
+	}
+	// with single-numa-node policy the pod never spans NUMA nodes, so there is no distance to weight
 	if conf.Policy != kubeletconfig.SingleNumaNodeTopologyManagerPolicy {
 		return nil
 	}
@@ -184,3 +188,17 @@ func (tm *TopologyMatch) scoringHandlerFromTopologyManagerConfig(conf nodeconfig
 	}
 	return nil // cannot happen
 }
+
+func (tm *TopologyMatch) distanceWeightedScoringHandler(conf nodeconfig.TopologyManager) scoringFn {
+	if conf.Scope == kubeletconfig.PodTopologyManagerScope {
+		return func(lh logr.Logger, pod *v1.Pod, zones topologyv1alpha2.ZoneList) (int64, *framework.Status) {
+			return distanceWeightedPodScopeScore(lh, pod, zones, tm.scoreStrategyFunc, tm.resourceToWeightMap)
+		}
+	}
+	if conf.Scope == kubeletconfig.ContainerTopologyManagerScope {
+		return func(lh logr.Logger, pod *v1.Pod, zones topologyv1alpha2.ZoneList) (int64, *framework.Status) {
+			return distanceWeightedContainerScopeScore(lh, pod, zones, tm.scoreStrategyFunc, tm.resourceToWeightMap)
+		}
+	}
+	return nil // cannot happen
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podstate
+
+import (
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+)
+
+// nominatedPods tracks the unbound pods carrying .status.nominatedNodeName, as seen by the
+// pod informer. The pod nominator of the framework only knows about the pods nominated
+// by this scheduler, while this also catches the pods nominated by other schedulers.
+type nominatedPods struct {
+	lock sync.RWMutex
+	// Key: node name
+	pods map[string]map[types.UID]*v1.Pod
+	// Key: pod UID
+	// Value: node name the pod is nominated to
+	nodes map[types.UID]string
+}
+
+func newNominatedPods() *nominatedPods {
+	return &nominatedPods{
+		pods:  make(map[string]map[types.UID]*v1.Pod),
+		nodes: make(map[types.UID]string),
+	}
+}
+
+func isNominated(pod *v1.Pod) bool {
+	return pod.Status.NominatedNodeName != "" && pod.Spec.NodeName == "" && pod.DeletionTimestamp == nil
+}
+
+// update tracks the pod if it is nominated, and stops tracking it otherwise.
+func (np *nominatedPods) update(pod *v1.Pod) {
+	np.lock.Lock()
+	defer np.lock.Unlock()
+
+	np.deleteLocked(pod.UID)
+	if !isNominated(pod) {
+		return
+	}
+	nodeName := pod.Status.NominatedNodeName
+	if np.pods[nodeName] == nil {
+		np.pods[nodeName] = make(map[types.UID]*v1.Pod)
+	}
+	np.pods[nodeName][pod.UID] = pod
+	np.nodes[pod.UID] = nodeName
+}
+
+func (np *nominatedPods) delete(pod *v1.Pod) {
+	np.lock.Lock()
+	defer np.lock.Unlock()
+	np.deleteLocked(pod.UID)
+}
+
+func (np *nominatedPods) deleteLocked(uid types.UID) {
+	nodeName, ok := np.nodes[uid]
+	if !ok {
+		return
+	}
+	delete(np.nodes, uid)
+	delete(np.pods[nodeName], uid)
+	if len(np.pods[nodeName]) == 0 {
+		delete(np.pods, nodeName)
+	}
+}
+
+// forNode returns the pods nominated to a node.
+func (np *nominatedPods) forNode(nodeName string) []*v1.Pod {
+	np.lock.RLock()
+	defer np.lock.RUnlock()
+
+	pods := make([]*v1.Pod, 0, len(np.pods[nodeName]))
+	for _, pod := range np.pods[nodeName] {
+		pods = append(pods, pod)
+	}
+	return pods
+}
+
+func (np *nominatedPods) eventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if pod, ok := obj.(*v1.Pod); ok {
+				np.update(pod)
+			}
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			if pod, ok := newObj.(*v1.Pod); ok {
+				np.update(pod)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			switch t := obj.(type) {
+			case *v1.Pod:
+				np.delete(t)
+			case cache.DeletedFinalStateUnknown:
+				if pod, ok := t.Obj.(*v1.Pod); ok {
+					np.delete(pod)
+				}
+			}
+		},
+	}
+}
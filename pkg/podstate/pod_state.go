@@ -23,13 +23,26 @@ import (
 
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	schedconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
 	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
+	"sigs.k8s.io/scheduler-plugins/pkg/util"
 )
 
 type PodState struct {
-	handle framework.Handle
+	handle    framework.Handle
+	args      *config.PodStateArgs
+	nominated *nominatedPods
 }
 
+// resourceScale is the score of a pod requesting the whole allocatable of a node,
+// in the Resources scoring mode
+const resourceScale = 1000
+
 var _ = framework.ScorePlugin(&PodState{})
 
 // Name is the name of the plugin used in the Registry and configurations.
@@ -46,9 +59,9 @@ func (ps *PodState) Score(ctx context.Context, state *framework.CycleState, pod
 		return 0, framework.NewStatus(framework.Error, fmt.Sprintf("getting node %q from Snapshot: %v", nodeName, err))
 	}
 
-	// pe.score favors nodes with terminating pods instead of nominated pods
-	// It calculates the sum of the node's terminating pods and nominated pods
-	return ps.score(nodeInfo)
+	// pe.score favors nodes with terminating pods instead of nominated and recently bound pods
+	// It calculates the weighted sum of the node's terminating pods, nominated pods and recently bound pods
+	return ps.score(pod, nodeInfo)
 }
 
 // ScoreExtensions of the Score plugin.
@@ -56,17 +69,85 @@ func (ps *PodState) ScoreExtensions() framework.ScoreExtensions {
 	return ps
 }
 
-func (ps *PodState) score(nodeInfo *framework.NodeInfo) (int64, *framework.Status) {
-	var terminatingPodNum, nominatedPodNum int64
-	// get nominated Pods for node from nominatedPodMap
-	nominatedPodNum = int64(len(ps.handle.NominatedPodsForNode(nodeInfo.Node().Name)))
+func (ps *PodState) score(pod *v1.Pod, nodeInfo *framework.NodeInfo) (int64, *framework.Status) {
+	var terminating, nominated, recentlyBound int64
 	for _, p := range nodeInfo.Pods {
-		// Pod is terminating if DeletionTimestamp has been set
 		if p.Pod.DeletionTimestamp != nil {
-			terminatingPodNum++
+			// Pod is terminating if DeletionTimestamp has been set
+			terminating += ps.podWeight(p.Pod, nodeInfo)
+		} else if p.Pod.Status.StartTime == nil {
+			// Pod is assumed or bound, but not started by the kubelet yet
+			recentlyBound += ps.podWeight(p.Pod, nodeInfo)
+		}
+	}
+	for _, p := range ps.nominatedPods(pod, nodeInfo.Node().Name) {
+		nominated += ps.podWeight(p, nodeInfo)
+	}
+	return ps.args.TerminatingWeight*terminating - ps.args.NominatedWeight*nominated - ps.args.RecentlyBoundWeight*recentlyBound, nil
+}
+
+// nominatedPods returns the pods nominated to a node, by this scheduler or by any other,
+// except the pod being scheduled.
+func (ps *PodState) nominatedPods(pod *v1.Pod, nodeName string) []*v1.Pod {
+	seen := sets.New[types.UID]()
+	if pod != nil {
+		seen.Insert(pod.UID)
+	}
+	var pods []*v1.Pod
+	// get nominated Pods for node from nominatedPodMap
+	for _, pi := range ps.handle.NominatedPodsForNode(nodeName) {
+		if pi.Pod.UID != "" && seen.Has(pi.Pod.UID) {
+			continue
+		}
+		seen.Insert(pi.Pod.UID)
+		pods = append(pods, pi.Pod)
+	}
+	for _, p := range ps.nominated.forNode(nodeName) {
+		if seen.Has(p.UID) {
+			continue
 		}
+		seen.Insert(p.UID)
+		pods = append(pods, p)
+	}
+	return pods
+}
+
+// podWeight returns the weight of a pod: one in the PodCount scoring mode, and the weighted
+// fraction of the node allocatable the pod requests, up to resourceScale, in the Resources one.
+func (ps *PodState) podWeight(pod *v1.Pod, nodeInfo *framework.NodeInfo) int64 {
+	if ps.args.ScoringMode != config.PodStateScoringResources {
+		return 1
+	}
+	requests := util.GetPodEffectiveRequest(pod)
+	allocatable := util.ResourceList(nodeInfo.Allocatable)
+	var fraction float64
+	var weightSum int64
+	for _, resource := range ps.args.Resources {
+		weightSum += resource.Weight
+		fraction += float64(resource.Weight) * requestedFraction(requests, allocatable, v1.ResourceName(resource.Name))
 	}
-	return terminatingPodNum - nominatedPodNum, nil
+	if weightSum == 0 {
+		return 0
+	}
+	return int64(math.Round(fraction * resourceScale / float64(weightSum)))
+}
+
+// requestedFraction returns the fraction of the allocatable of a resource which is requested,
+// capped to one.
+func requestedFraction(requests, allocatable v1.ResourceList, name v1.ResourceName) float64 {
+	request, ok := requests[name]
+	if !ok {
+		return 0
+	}
+	alloc, ok := allocatable[name]
+	if !ok || alloc.IsZero() {
+		return 0
+	}
+	fraction := float64(request.MilliValue()) / float64(alloc.MilliValue())
+	if fraction > 1 {
+		return 1
+	}
+	return fraction
 }
 
 func (ps *PodState) NormalizeScore(ctx context.Context, state *framework.CycleState, pod *v1.Pod, scores framework.NodeScoreList) *framework.Status {
@@ -97,6 +178,42 @@ func (ps *PodState) NormalizeScore(ctx context.Context, state *framework.CycleSt
 }
 
 // New initializes a new plugin and returns it.
-func New(_ context.Context, _ runtime.Object, h framework.Handle) (framework.Plugin, error) {
-	return &PodState{handle: h}, nil
+func New(_ context.Context, obj runtime.Object, h framework.Handle) (framework.Plugin, error) {
+	args := defaultArgs()
+	if obj != nil {
+		var ok bool
+		args, ok = obj.(*config.PodStateArgs)
+		if !ok {
+			return nil, fmt.Errorf("want args to be of type PodStateArgs, got %T", obj)
+		}
+	}
+	if err := validation.ValidatePodStateArgs(nil, args); err != nil {
+		return nil, err
+	}
+
+	ps := &PodState{
+		handle:    h,
+		args:      args,
+		nominated: newNominatedPods(),
+	}
+	if h.SharedInformerFactory() != nil {
+		if _, err := h.SharedInformerFactory().Core().V1().Pods().Informer().AddEventHandler(ps.nominated.eventHandler()); err != nil {
+			return nil, err
+		}
+	}
+	return ps, nil
+}
+
+// defaultArgs returns the args used when the plugin is not configured, which score the pods
+// as the number of terminating pods minus the number of nominated pods.
+func defaultArgs() *config.PodStateArgs {
+	return &config.PodStateArgs{
+		TerminatingWeight: 1,
+		NominatedWeight:   1,
+		ScoringMode:       config.PodStateScoringPodCount,
+		Resources: []schedconfig.ResourceSpec{
+			{Name: string(v1.ResourceCPU), Weight: 1},
+			{Name: string(v1.ResourceMemory), Weight: 1},
+		},
+	}
 }
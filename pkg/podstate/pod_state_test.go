@@ -19,22 +19,28 @@ package podstate
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/informers"
 	clientsetfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/klog/v2"
 	"k8s.io/klog/v2/ktesting"
 
+	schedconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
 	"k8s.io/kubernetes/pkg/scheduler/framework"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/defaultbinder"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/queuesort"
 	frameworkruntime "k8s.io/kubernetes/pkg/scheduler/framework/runtime"
 	tf "k8s.io/kubernetes/pkg/scheduler/testing/framework"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
 	testutil "sigs.k8s.io/scheduler-plugins/test/util"
 )
 
@@ -204,3 +210,249 @@ func (f *fakeSharedLister) StorageInfos() framework.StorageInfoLister {
 func (f *fakeSharedLister) NodeInfos() framework.NodeInfoLister {
 	return tf.NodeInfoLister(f.nodes)
 }
+
+func TestPodStateArgs(t *testing.T) {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("10"),
+				v1.ResourceMemory: resource.MustParse("10Gi"),
+			},
+		},
+	}
+	started := metav1.Now()
+	running := makeResourcePod("running", "1", "1Gi")
+	running.Status.StartTime = &started
+	terminating := makeResourcePod("terminating", "4", "4Gi")
+	terminating.Status.StartTime = &started
+	terminating.DeletionTimestamp = &started
+	recentlyBound := makeResourcePod("recently-bound", "1", "1Gi")
+	nominated := makeResourcePod("nominated", "2", "2Gi")
+	nominated.Status.NominatedNodeName = "node1"
+	foreignNominated := makeResourcePod("foreign-nominated", "1", "1Gi")
+	foreignNominated.Status.NominatedNodeName = "node1"
+
+	tests := []struct {
+		name          string
+		args          *config.PodStateArgs
+		pod           *v1.Pod
+		foreign       []*v1.Pod
+		expectedScore int64
+	}{
+		{
+			name:          "default args count the terminating and nominated pods",
+			expectedScore: 1 - 1,
+		},
+		{
+			name:          "pods nominated by other schedulers are counted",
+			foreign:       []*v1.Pod{foreignNominated},
+			expectedScore: 1 - 2,
+		},
+		{
+			name:          "pods nominated by both this scheduler and another one are counted once",
+			foreign:       []*v1.Pod{foreignNominated, nominated},
+			expectedScore: 1 - 2,
+		},
+		{
+			name:          "the pod being scheduled is not counted as nominated",
+			pod:           nominated,
+			expectedScore: 1,
+		},
+		{
+			name: "weighted pod count",
+			args: &config.PodStateArgs{
+				TerminatingWeight:   5,
+				NominatedWeight:     2,
+				RecentlyBoundWeight: 1,
+				ScoringMode:         config.PodStateScoringPodCount,
+			},
+			expectedScore: 5 - 2 - 1,
+		},
+		{
+			name: "weighted freed resources",
+			args: &config.PodStateArgs{
+				TerminatingWeight:   1,
+				NominatedWeight:     1,
+				RecentlyBoundWeight: 1,
+				ScoringMode:         config.PodStateScoringResources,
+				Resources: []schedconfig.ResourceSpec{
+					{Name: string(v1.ResourceCPU), Weight: 1},
+					{Name: string(v1.ResourceMemory), Weight: 1},
+				},
+			},
+			foreign:       []*v1.Pod{foreignNominated},
+			expectedScore: 400 - 200 - 100 - 100,
+		},
+		{
+			name: "weighted freed resources, considering cpu only",
+			args: &config.PodStateArgs{
+				TerminatingWeight: 1,
+				ScoringMode:       config.PodStateScoringResources,
+				Resources:         []schedconfig.ResourceSpec{{Name: string(v1.ResourceCPU), Weight: 1}},
+			},
+			expectedScore: 400,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			logger, ctx := ktesting.NewTestContext(t)
+
+			nodeInfo := framework.NewNodeInfo(running, terminating, recentlyBound)
+			nodeInfo.SetNode(node)
+
+			cs := clientsetfake.NewSimpleClientset()
+			informerFactory := informers.NewSharedInformerFactory(cs, 0)
+			registeredPlugins := []tf.RegisterPluginFunc{
+				tf.RegisterBindPlugin(defaultbinder.Name, defaultbinder.New),
+				tf.RegisterQueueSortPlugin(queuesort.Name, queuesort.New),
+			}
+			fh, err := tf.NewFramework(
+				ctx,
+				registeredPlugins,
+				"default-scheduler",
+				frameworkruntime.WithClientSet(cs),
+				frameworkruntime.WithInformerFactory(informerFactory),
+				frameworkruntime.WithSnapshotSharedLister(&fakeSharedLister{nodes: []*framework.NodeInfo{nodeInfo}}),
+				frameworkruntime.WithPodNominator(testutil.NewPodNominator(nil)),
+			)
+			if err != nil {
+				t.Fatalf("fail to create framework: %s", err)
+			}
+			podInfo, _ := framework.NewPodInfo(nominated)
+			addNominatedPod(logger, podInfo, "node1", fh)
+
+			var args runtime.Object
+			if test.args != nil {
+				args = test.args
+			}
+			pl, err := New(ctx, args, fh)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			ps := pl.(*PodState)
+			for _, p := range test.foreign {
+				ps.nominated.update(p)
+			}
+
+			pod := test.pod
+			if pod == nil {
+				pod = makeResourcePod("incoming", "1", "1Gi")
+			}
+			score, status := ps.Score(ctx, nil, pod, "node1")
+			if !status.IsSuccess() {
+				t.Fatalf("unexpected error: %v", status)
+			}
+			if score != test.expectedScore {
+				t.Errorf("expected score %d, got %d", test.expectedScore, score)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    runtime.Object
+		wantErr string
+	}{
+		{
+			name: "nil args",
+		},
+		{
+			name:    "wrong args type",
+			args:    &config.QOSSortArgs{},
+			wantErr: "want args to be of type PodStateArgs",
+		},
+		{
+			name: "negative weight",
+			args: &config.PodStateArgs{
+				TerminatingWeight: -1,
+				ScoringMode:       config.PodStateScoringPodCount,
+			},
+			wantErr: "terminatingWeight: Invalid value",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			cs := clientsetfake.NewSimpleClientset()
+			fh, err := tf.NewFramework(
+				ctx,
+				[]tf.RegisterPluginFunc{
+					tf.RegisterBindPlugin(defaultbinder.Name, defaultbinder.New),
+					tf.RegisterQueueSortPlugin(queuesort.Name, queuesort.New),
+				},
+				"default-scheduler",
+				frameworkruntime.WithClientSet(cs),
+				frameworkruntime.WithInformerFactory(informers.NewSharedInformerFactory(cs, 0)),
+			)
+			if err != nil {
+				t.Fatalf("fail to create framework: %s", err)
+			}
+			_, err = New(ctx, test.args, fh)
+			if test.wantErr == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if test.wantErr != "" && (err == nil || !strings.Contains(err.Error(), test.wantErr)) {
+				t.Errorf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNominatedPods(t *testing.T) {
+	np := newNominatedPods()
+	pod := makeNominatedPod("pod", "node1")
+	np.update(pod)
+	if got := len(np.forNode("node1")); got != 1 {
+		t.Fatalf("expected 1 nominated pod on node1, got %d", got)
+	}
+
+	// nominated to another node
+	moved := pod.DeepCopy()
+	moved.Status.NominatedNodeName = "node2"
+	np.update(moved)
+	if got := len(np.forNode("node1")); got != 0 {
+		t.Errorf("expected no nominated pod on node1, got %d", got)
+	}
+	if got := len(np.forNode("node2")); got != 1 {
+		t.Errorf("expected 1 nominated pod on node2, got %d", got)
+	}
+
+	// bound
+	bound := moved.DeepCopy()
+	bound.Spec.NodeName = "node2"
+	np.update(bound)
+	if got := len(np.forNode("node2")); got != 0 {
+		t.Errorf("expected no nominated pod on node2 once bound, got %d", got)
+	}
+
+	np.update(moved)
+	np.delete(moved)
+	if len(np.pods) != 0 || len(np.nodes) != 0 {
+		t.Errorf("expected no tracked pod after deletion, got %v", np.pods)
+	}
+}
+
+func makeResourcePod(name, cpu, memory string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			UID:  types.UID(name),
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "app",
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse(cpu),
+						v1.ResourceMemory: resource.MustParse(memory),
+					},
+				},
+			}},
+		},
+	}
+}
@@ -18,19 +18,42 @@ package qos
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	corev1helpers "k8s.io/component-helpers/scheduling/corev1"
 	v1qos "k8s.io/kubernetes/pkg/apis/core/v1/helper/qos"
 	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
+	"sigs.k8s.io/scheduler-plugins/pkg/util"
 )
 
 // Name is the name of the plugin used in the plugin registry and configurations.
 const Name = "QOSSort"
 
+// compareFunc compares two Pods and returns:
+//
+//	 1 if p1 should be scheduled before p2,
+//	-1 if p2 should be scheduled before p1,
+//	 0 if the key does not tell the pods apart.
+type compareFunc func(p1, p2 *v1.Pod) int
+
+// defaultKeys sort the pods by priority, then by QoS class.
+var defaultKeys = []compareFunc{compPriority, compQOS}
+
+// defaultResources are the resources compared by the RequestedSize key if none is given.
+var defaultResources = []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory}
+
 // Sort is a plugin that implements QoS class based sorting.
-type Sort struct{}
+// The order can be configured with a list of keys, tried in order until one tells the pods apart.
+type Sort struct {
+	keys []compareFunc
+}
 
 var _ framework.QueueSortPlugin = &Sort{}
 
@@ -40,23 +63,27 @@ func (pl *Sort) Name() string {
 }
 
 // Less is the function used by the activeQ heap algorithm to sort pods.
-// It sorts pods based on their priorities. When the priorities are equal, it uses
-// the Pod QoS classes to break the tie. If both the priority and QoS class are equal,
+// It sorts pods using the configured keys, by default based on their priorities and, when
+// the priorities are equal, on the Pod QoS classes to break the tie. If all the keys are equal,
 // it uses PodQueueInfo.timestamp to determine the order.
-func (*Sort) Less(pInfo1, pInfo2 *framework.QueuedPodInfo) bool {
-	p1 := corev1helpers.PodPriority(pInfo1.Pod)
-	p2 := corev1helpers.PodPriority(pInfo2.Pod)
-
-	if p1 != p2 {
-		return p1 > p2
+func (pl *Sort) Less(pInfo1, pInfo2 *framework.QueuedPodInfo) bool {
+	keys := pl.keys
+	if len(keys) == 0 {
+		keys = defaultKeys
 	}
-	qosResult := compQOS(pInfo1.Pod, pInfo2.Pod)
-	if qosResult != 0 {
-		return qosResult > 0
+	for _, cmp := range keys {
+		if result := cmp(pInfo1.Pod, pInfo2.Pod); result != 0 {
+			return result > 0
+		}
 	}
 	return pInfo1.Timestamp.Before(pInfo2.Timestamp)
 }
 
+// compPriority gives precedence to the Pod with the higher priority.
+func compPriority(p1, p2 *v1.Pod) int {
+	return compInt64(int64(corev1helpers.PodPriority(p1)), int64(corev1helpers.PodPriority(p2)))
+}
+
 // compQOS compares the QoS classes of two Pods and returns:
 //
 //	 1 if p1 has a higher precedence QoS class than p2,
@@ -80,7 +107,135 @@ func compQOS(p1, p2 *v1.Pod) int {
 	return 0
 }
 
+// compRequestedSize gives precedence to the Pod with the smaller requests of the given resources,
+// compared one after the other.
+func compRequestedSize(resources []v1.ResourceName) compareFunc {
+	return func(p1, p2 *v1.Pod) int {
+		r1, r2 := util.GetPodEffectiveRequest(p1), util.GetPodEffectiveRequest(p2)
+		for _, name := range resources {
+			q1, q2 := r1[name], r2[name]
+			if result := q2.Cmp(q1); result != 0 {
+				return result
+			}
+		}
+		return 0
+	}
+}
+
+// compNamespaceWeight gives precedence to the Pod whose namespace has the higher weight.
+func compNamespaceWeight(weights map[string]int64) compareFunc {
+	return func(p1, p2 *v1.Pod) int {
+		return compInt64(weights[p1.Namespace], weights[p2.Namespace])
+	}
+}
+
+// compPodLabel gives precedence to the Pod with the higher value of the given label, comparing the
+// values as integers if both are, as strings otherwise. Pods without the label come last.
+func compPodLabel(key string) compareFunc {
+	return func(p1, p2 *v1.Pod) int {
+		val1, ok1 := p1.Labels[key]
+		val2, ok2 := p2.Labels[key]
+		if !ok1 || !ok2 {
+			return compBool(ok1, ok2)
+		}
+		i1, err1 := strconv.ParseInt(val1, 10, 64)
+		i2, err2 := strconv.ParseInt(val2, 10, 64)
+		if err1 == nil && err2 == nil {
+			return compInt64(i1, i2)
+		}
+		return strings.Compare(val1, val2)
+	}
+}
+
+// compCreationTime gives precedence to the Pod created first.
+func compCreationTime(p1, p2 *v1.Pod) int {
+	t1, t2 := p1.CreationTimestamp, p2.CreationTimestamp
+	if t1.Equal(&t2) {
+		return 0
+	}
+	if t1.Before(&t2) {
+		return 1
+	}
+	return -1
+}
+
+func compInt64(i1, i2 int64) int {
+	if i1 > i2 {
+		return 1
+	} else if i1 < i2 {
+		return -1
+	}
+	return 0
+}
+
+func compBool(b1, b2 bool) int {
+	if b1 == b2 {
+		return 0
+	}
+	if b1 {
+		return 1
+	}
+	return -1
+}
+
+func reverse(cmp compareFunc) compareFunc {
+	return func(p1, p2 *v1.Pod) int {
+		return -cmp(p1, p2)
+	}
+}
+
+// newCompareFunc returns the compareFunc implementing a key.
+func newCompareFunc(key config.QueueSortKey) (compareFunc, error) {
+	var cmp compareFunc
+	switch key.Type {
+	case config.QueueSortKeyPriority:
+		cmp = compPriority
+	case config.QueueSortKeyQOSClass:
+		cmp = compQOS
+	case config.QueueSortKeyRequestedSize:
+		resources := key.Resources
+		if len(resources) == 0 {
+			resources = defaultResources
+		}
+		cmp = compRequestedSize(resources)
+	case config.QueueSortKeyNamespaceWeight:
+		cmp = compNamespaceWeight(key.NamespaceWeights)
+	case config.QueueSortKeyPodLabel:
+		if key.LabelKey == "" {
+			return nil, fmt.Errorf("labelKey is required by the %s queue sort key", key.Type)
+		}
+		cmp = compPodLabel(key.LabelKey)
+	case config.QueueSortKeyCreationTime:
+		cmp = compCreationTime
+	default:
+		return nil, fmt.Errorf("invalid queue sort key type %q", key.Type)
+	}
+	if key.Reverse {
+		cmp = reverse(cmp)
+	}
+	return cmp, nil
+}
+
 // New initializes a new plugin and returns it.
-func New(_ context.Context, _ runtime.Object, _ framework.Handle) (framework.Plugin, error) {
-	return &Sort{}, nil
+func New(_ context.Context, obj runtime.Object, _ framework.Handle) (framework.Plugin, error) {
+	if obj == nil {
+		return &Sort{}, nil
+	}
+	args, ok := obj.(*config.QOSSortArgs)
+	if !ok {
+		return nil, fmt.Errorf("want args to be of type QOSSortArgs, got %T", obj)
+	}
+	if err := validation.ValidateQOSSortArgs(nil, args); err != nil {
+		return nil, err
+	}
+
+	keys := make([]compareFunc, 0, len(args.Keys))
+	for _, key := range args.Keys {
+		cmp, err := newCompareFunc(key)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, cmp)
+	}
+	return &Sort{keys: keys}, nil
 }
@@ -17,13 +17,17 @@ limitations under the License.
 package qos
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"sigs.k8s.io/scheduler-plugins/apis/config"
 )
 
 func createPodInfo(pod *v1.Pod) *framework.PodInfo {
@@ -179,3 +183,145 @@ func getResList(cpu, memory string) v1.ResourceList {
 	}
 	return res
 }
+
+func TestSortLessWithKeys(t *testing.T) {
+	earlierTime := time.Now()
+	laterTime := earlierTime.Add(time.Second)
+
+	withNamespace := func(pod *v1.Pod, namespace string) *v1.Pod {
+		pod.Namespace = namespace
+		return pod
+	}
+	withLabel := func(pod *v1.Pod, value string) *v1.Pod {
+		pod.Labels = map[string]string{"tier": value}
+		return pod
+	}
+	withCreationTime := func(pod *v1.Pod, creationTime time.Time) *v1.Pod {
+		pod.CreationTimestamp = metav1.NewTime(creationTime)
+		return pod
+	}
+
+	tests := []struct {
+		name string
+		keys []config.QueueSortKey
+		pod1 *v1.Pod
+		pod2 *v1.Pod
+		want bool
+	}{
+		{
+			name: "smaller pod first within the same priority",
+			keys: []config.QueueSortKey{{Type: config.QueueSortKeyPriority}, {Type: config.QueueSortKeyRequestedSize}},
+			pod1: makePod("p1", 10, getResList("2", "1Gi"), nil),
+			pod2: makePod("p2", 10, getResList("1", "2Gi"), nil),
+			want: false,
+		},
+		{
+			name: "priority before requested size",
+			keys: []config.QueueSortKey{{Type: config.QueueSortKeyPriority}, {Type: config.QueueSortKeyRequestedSize}},
+			pod1: makePod("p1", 20, getResList("2", "1Gi"), nil),
+			pod2: makePod("p2", 10, getResList("1", "2Gi"), nil),
+			want: true,
+		},
+		{
+			name: "requested size on memory only",
+			keys: []config.QueueSortKey{{Type: config.QueueSortKeyRequestedSize, Resources: []v1.ResourceName{v1.ResourceMemory}}},
+			pod1: makePod("p1", 10, getResList("2", "1Gi"), nil),
+			pod2: makePod("p2", 10, getResList("1", "2Gi"), nil),
+			want: true,
+		},
+		{
+			name: "reversed requested size",
+			keys: []config.QueueSortKey{{Type: config.QueueSortKeyRequestedSize, Reverse: true}},
+			pod1: makePod("p1", 10, getResList("2", "1Gi"), nil),
+			pod2: makePod("p2", 10, getResList("1", "2Gi"), nil),
+			want: true,
+		},
+		{
+			name: "namespace weight",
+			keys: []config.QueueSortKey{{Type: config.QueueSortKeyNamespaceWeight, NamespaceWeights: map[string]int64{"prod": 10}}},
+			pod1: withNamespace(makePod("p1", 10, nil, nil), "dev"),
+			pod2: withNamespace(makePod("p2", 10, nil, nil), "prod"),
+			want: false,
+		},
+		{
+			name: "numeric label values",
+			keys: []config.QueueSortKey{{Type: config.QueueSortKeyPodLabel, LabelKey: "tier"}},
+			pod1: withLabel(makePod("p1", 10, nil, nil), "10"),
+			pod2: withLabel(makePod("p2", 10, nil, nil), "9"),
+			want: true,
+		},
+		{
+			name: "pods without the label come last",
+			keys: []config.QueueSortKey{{Type: config.QueueSortKeyPodLabel, LabelKey: "tier"}},
+			pod1: makePod("p1", 10, nil, nil),
+			pod2: withLabel(makePod("p2", 10, nil, nil), "low"),
+			want: false,
+		},
+		{
+			name: "creation time",
+			keys: []config.QueueSortKey{{Type: config.QueueSortKeyCreationTime}},
+			pod1: withCreationTime(makePod("p1", 10, nil, nil), earlierTime),
+			pod2: withCreationTime(makePod("p2", 10, nil, nil), laterTime),
+			want: true,
+		},
+		{
+			name: "tie broken by the queue timestamp",
+			keys: []config.QueueSortKey{{Type: config.QueueSortKeyNamespaceWeight}},
+			pod1: makePod("p1", 10, nil, nil),
+			pod2: makePod("p2", 10, nil, nil),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pl, err := New(context.Background(), &config.QOSSortArgs{Keys: tt.keys}, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			pInfo1 := &framework.QueuedPodInfo{PodInfo: createPodInfo(tt.pod1), Timestamp: laterTime}
+			pInfo2 := &framework.QueuedPodInfo{PodInfo: createPodInfo(tt.pod2), Timestamp: earlierTime}
+			if got := pl.(*Sort).Less(pInfo1, pInfo2); got != tt.want {
+				t.Errorf("Less() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    runtime.Object
+		wantErr bool
+	}{
+		{
+			name: "no args",
+		},
+		{
+			name: "valid keys",
+			args: &config.QOSSortArgs{Keys: []config.QueueSortKey{{Type: config.QueueSortKeyPriority}, {Type: config.QueueSortKeyPodLabel, LabelKey: "tier"}}},
+		},
+		{
+			name:    "unknown key",
+			args:    &config.QOSSortArgs{Keys: []config.QueueSortKey{{Type: "Unknown"}}},
+			wantErr: true,
+		},
+		{
+			name:    "label key without label",
+			args:    &config.QOSSortArgs{Keys: []config.QueueSortKey{{Type: config.QueueSortKeyPodLabel}}},
+			wantErr: true,
+		},
+		{
+			name:    "wrong args type",
+			args:    &config.SySchedArgs{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(context.Background(), tt.args, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sysched
+
+import (
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// hostPod is a pod running on a host, along with the system calls it was accounted with
+type hostPod struct {
+	pod      *v1.Pod
+	syscalls sets.Set[string]
+}
+
+// nodeState holds the pods running on a host and the union of their system calls,
+// kept as per-syscall reference counts so that adding or removing a pod costs
+// as much as the size of its profile
+type nodeState struct {
+	pods map[types.NamespacedName]*hostPod
+	// Key: system call name
+	// Value: number of pods on the host using the system call
+	syscalls map[string]int
+}
+
+func (ns *nodeState) ref(syscalls sets.Set[string]) {
+	for syscall := range syscalls {
+		ns.syscalls[syscall]++
+	}
+}
+
+func (ns *nodeState) unref(syscalls sets.Set[string]) {
+	for syscall := range syscalls {
+		ns.syscalls[syscall]--
+		if ns.syscalls[syscall] <= 0 {
+			delete(ns.syscalls, syscall)
+		}
+	}
+}
+
+// hostState maintains the state of what pods are on each node, including the pods
+// scheduled by other schedulers which the cached state from SharedLister does not hold,
+// and the running average of the extraneous system calls. It is safe for concurrent use:
+// the informer handlers update it while Filter and Score read it in parallel.
+type hostState struct {
+	lock sync.RWMutex
+	// Key: node name
+	nodes       map[string]*nodeState
+	exSAvg      float64
+	exSAvgCount int64
+}
+
+func newHostState() *hostState {
+	return &hostState{
+		nodes:       make(map[string]*nodeState),
+		exSAvgCount: 1,
+	}
+}
+
+func podKey(pod *v1.Pod) types.NamespacedName {
+	return types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
+}
+
+// addPod accounts a pod on a host with the given system calls.
+// Returns false if the pod is already accounted.
+func (hs *hostState) addPod(nodeName string, pod *v1.Pod, syscalls sets.Set[string]) bool {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+
+	ns, ok := hs.nodes[nodeName]
+	if !ok {
+		ns = &nodeState{
+			pods:     make(map[types.NamespacedName]*hostPod),
+			syscalls: make(map[string]int),
+		}
+		hs.nodes[nodeName] = ns
+	}
+	key := podKey(pod)
+	if _, ok := ns.pods[key]; ok {
+		return false
+	}
+	ns.pods[key] = &hostPod{pod: pod, syscalls: syscalls}
+	ns.ref(syscalls)
+	return true
+}
+
+// removePod drops a pod from a host. Returns false if the pod is not accounted.
+func (hs *hostState) removePod(nodeName string, pod *v1.Pod) bool {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+
+	ns, ok := hs.nodes[nodeName]
+	if !ok {
+		return false
+	}
+	key := podKey(pod)
+	hp, ok := ns.pods[key]
+	if !ok {
+		return false
+	}
+	delete(ns.pods, key)
+	ns.unref(hp.syscalls)
+	return true
+}
+
+// updatePodSyscalls replaces the system calls a pod is accounted with, if the pod is accounted.
+func (hs *hostState) updatePodSyscalls(nodeName string, pod *v1.Pod, syscalls sets.Set[string]) {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+
+	ns, ok := hs.nodes[nodeName]
+	if !ok {
+		return
+	}
+	hp, ok := ns.pods[podKey(pod)]
+	if !ok {
+		return
+	}
+	ns.unref(hp.syscalls)
+	hp.syscalls = syscalls
+	ns.ref(syscalls)
+}
+
+// podsWithUIDs returns the accounted pods with any of the given UIDs, keyed by node name.
+func (hs *hostState) podsWithUIDs(uids sets.Set[types.UID]) map[string][]*v1.Pod {
+	hs.lock.RLock()
+	defer hs.lock.RUnlock()
+
+	pods := make(map[string][]*v1.Pod)
+	for nodeName, ns := range hs.nodes {
+		for _, hp := range ns.pods {
+			if uids.Has(hp.pod.UID) {
+				pods[nodeName] = append(pods[nodeName], hp.pod)
+			}
+		}
+	}
+	return pods
+}
+
+// getPods returns the pods accounted on a host.
+func (hs *hostState) getPods(nodeName string) []*v1.Pod {
+	hs.lock.RLock()
+	defer hs.lock.RUnlock()
+
+	ns, ok := hs.nodes[nodeName]
+	if !ok {
+		return nil
+	}
+	pods := make([]*v1.Pod, 0, len(ns.pods))
+	for _, hp := range ns.pods {
+		pods = append(pods, hp.pod)
+	}
+	return pods
+}
+
+// snapshot returns the pods accounted on a host, along with their system calls, and the
+// system calls of the host, or nil if the host is not known yet. The returned data is not
+// affected by later changes to the state.
+func (hs *hostState) snapshot(nodeName string) ([]hostPod, sets.Set[string]) {
+	hs.lock.RLock()
+	defer hs.lock.RUnlock()
+
+	ns, ok := hs.nodes[nodeName]
+	if !ok {
+		return nil, nil
+	}
+	pods := make([]hostPod, 0, len(ns.pods))
+	for _, hp := range ns.pods {
+		pods = append(pods, *hp)
+	}
+	syscalls := make(sets.Set[string], len(ns.syscalls))
+	for syscall := range ns.syscalls {
+		syscalls.Insert(syscall)
+	}
+	return pods, syscalls
+}
+
+// recordExposure updates the running average of the extraneous system calls and returns it.
+func (hs *hostState) recordExposure(totalDiffs int) float64 {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+
+	hs.exSAvg = hs.exSAvg + (float64(totalDiffs)-hs.exSAvg)/float64(hs.exSAvgCount)
+	hs.exSAvgCount += 1
+	return hs.exSAvg
+}
+
+// hostSyscalls returns the sorted system calls used by the pods of each node, by node name.
+func (hs *hostState) hostSyscalls() map[string][]string {
+	hs.lock.RLock()
+	defer hs.lock.RUnlock()
+
+	result := make(map[string][]string, len(hs.nodes))
+	for nodeName, ns := range hs.nodes {
+		result[nodeName] = sets.List(sets.KeySet(ns.syscalls))
+	}
+	return result
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sysched
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	st "k8s.io/kubernetes/pkg/scheduler/testing"
+)
+
+func hostSyscallCount(sys *SySched, nodeName string) int {
+	c, _ := sys.getHostSyscalls(klog.FromContext(context.TODO()), nodeName)
+	return c
+}
+
+func TestHostStateRefCounts(t *testing.T) {
+	hs := newHostState()
+	pod1 := st.MakePod().Name("pod1").Namespace("ns").Obj()
+	pod2 := st.MakePod().Name("pod2").Namespace("ns").Obj()
+	pod3 := st.MakePod().Name("pod1").Namespace("other").Obj()
+
+	assert.True(t, hs.addPod("node", pod1, sets.New[string]("read", "write", "ptrace")))
+	assert.True(t, hs.addPod("node", pod2, sets.New[string]("read", "write")))
+	assert.True(t, hs.addPod("node", pod3, sets.New[string]("read", "bpf")))
+	assert.False(t, hs.addPod("node", pod1, sets.New[string]("mount")))
+
+	pods, syscalls := hs.snapshot("node")
+	assert.Len(t, pods, 3)
+	assert.True(t, syscalls.Equal(sets.New[string]("read", "write", "ptrace", "bpf")))
+
+	assert.True(t, hs.removePod("node", pod1))
+	assert.False(t, hs.removePod("node", pod1))
+	_, syscalls = hs.snapshot("node")
+	assert.True(t, syscalls.Equal(sets.New[string]("read", "write", "bpf")))
+
+	hs.updatePodSyscalls("node", pod3, sets.New[string]("read", "unshare"))
+	_, syscalls = hs.snapshot("node")
+	assert.True(t, syscalls.Equal(sets.New[string]("read", "write", "unshare")))
+	assert.Equal(t, map[string][]string{"node": {"read", "unshare", "write"}}, hs.hostSyscalls())
+
+	assert.True(t, hs.removePod("node", pod2))
+	assert.True(t, hs.removePod("node", pod3))
+	pods, syscalls = hs.snapshot("node")
+	assert.Empty(t, pods)
+	assert.Equal(t, 0, syscalls.Len())
+
+	pods, syscalls = hs.snapshot("unknown")
+	assert.Nil(t, pods)
+	assert.Nil(t, syscalls)
+}
+
+func TestHostStateConcurrency(t *testing.T) {
+	hs := newHostState()
+	syscalls := sets.New[string]("read", "write")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				pod := st.MakePod().Name(fmt.Sprintf("pod-%d-%d", i, j)).Obj()
+				hs.addPod("node", pod, syscalls)
+				hs.snapshot("node")
+				hs.recordExposure(j)
+				if j%2 == 0 {
+					hs.removePod("node", pod)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	pods, hostSyscalls := hs.snapshot("node")
+	assert.Len(t, pods, 8*25)
+	assert.True(t, hostSyscalls.Equal(syscalls))
+	assert.EqualValues(t, 8*50+1, hs.exSAvgCount)
+}
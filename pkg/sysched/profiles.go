@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sysched
+
+import (
+	"sync"
+
+	"github.com/containers/common/pkg/seccomp"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+// podSyscalls is the system call set computed for a pod
+type podSyscalls struct {
+	syscalls sets.Set[string]
+	// true if the pod got the syscalls of the default profile
+	unconfined bool
+	// keys of the profiles the syscalls are computed from
+	profiles sets.Set[string]
+}
+
+// profileCache holds the allowed system calls of the SeccompProfile objects, keyed by namespace/name,
+// and the system call sets computed for the pods from them. It is fed by the SeccompProfile informer,
+// so reading the profiles needs no API call. The cached sets are shared and must not be mutated.
+type profileCache struct {
+	lock     sync.RWMutex
+	profiles map[string]sets.Set[string]
+	pods     map[types.UID]*podSyscalls
+	// generation is bumped on every profile change, so that a pod system call set
+	// computed while a profile changed is not cached past its invalidation
+	generation uint64
+}
+
+func newProfileCache() *profileCache {
+	return &profileCache{
+		profiles: make(map[string]sets.Set[string]),
+		pods:     make(map[types.UID]*podSyscalls),
+	}
+}
+
+func profileKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// allowedSyscalls returns the system calls the profile lets the workloads run
+func allowedSyscalls(profile *v1beta1.SeccompProfile) sets.Set[string] {
+	syscalls := sets.New[string]()
+
+	// need to merge the syscalls in the syscall categories
+	// from multiple relevant actions, e.g., allow, log, notify
+	for _, element := range profile.Spec.Syscalls {
+		// NOTE: should we consider the other categories, e.g., notify, trace?
+		// SCMP_ACT_TRACE --> ActTrace, seccomp.ActNotify
+		if element != nil && (element.Action == seccomp.ActAllow || element.Action == seccomp.ActLog) {
+			syscalls = syscalls.Union(sets.New[string](element.Names...))
+		}
+	}
+
+	return syscalls
+}
+
+func (pc *profileCache) getProfile(namespace, name string) (sets.Set[string], bool) {
+	pc.lock.RLock()
+	defer pc.lock.RUnlock()
+	syscalls, ok := pc.profiles[profileKey(namespace, name)]
+	return syscalls, ok
+}
+
+// setProfile adds or updates a profile and returns the pods whose system call set is invalidated.
+func (pc *profileCache) setProfile(profile *v1beta1.SeccompProfile) []types.UID {
+	syscalls := allowedSyscalls(profile)
+	key := profileKey(profile.Namespace, profile.Name)
+
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+	pc.profiles[key] = syscalls
+	pc.generation++
+	return pc.invalidatePodsLocked(key)
+}
+
+// deleteProfile deletes a profile and returns the pods whose system call set is invalidated.
+func (pc *profileCache) deleteProfile(namespace, name string) []types.UID {
+	key := profileKey(namespace, name)
+
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+	delete(pc.profiles, key)
+	pc.generation++
+	return pc.invalidatePodsLocked(key)
+}
+
+func (pc *profileCache) invalidatePodsLocked(key string) []types.UID {
+	var uids []types.UID
+	for uid, ps := range pc.pods {
+		if ps.profiles.Has(key) {
+			delete(pc.pods, uid)
+			uids = append(uids, uid)
+		}
+	}
+	return uids
+}
+
+func (pc *profileCache) getPod(uid types.UID) (*podSyscalls, bool) {
+	pc.lock.RLock()
+	defer pc.lock.RUnlock()
+	ps, ok := pc.pods[uid]
+	return ps, ok
+}
+
+// getGeneration returns the generation of the profiles, to be read before computing a pod system call set.
+func (pc *profileCache) getGeneration() uint64 {
+	pc.lock.RLock()
+	defer pc.lock.RUnlock()
+	return pc.generation
+}
+
+// setPod caches the system call set of a pod, computed from the profiles of the given generation.
+// The set is not cached if a profile changed since, as it may be computed from the old profile.
+// Pods without UID are never cached.
+func (pc *profileCache) setPod(uid types.UID, ps *podSyscalls, generation uint64) {
+	if uid == "" {
+		return
+	}
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+	if pc.generation != generation {
+		return
+	}
+	pc.pods[uid] = ps
+}
+
+func (pc *profileCache) deletePod(uid types.UID) {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+	delete(pc.pods, uid)
+}
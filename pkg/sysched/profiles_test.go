@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sysched
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	st "k8s.io/kubernetes/pkg/scheduler/testing"
+	"sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+func TestProfileCacheInvalidation(t *testing.T) {
+	sys, err := mockSysched()
+	assert.Nil(t, err)
+	logger := klog.FromContext(context.TODO())
+
+	pod := st.MakePod().Name("pod").UID("pod-uid").Node("test").
+		Annotation("seccomp.security.alpha.kubernetes.io", "localhost/operator/default/new-seccomp.json").Obj()
+	other := st.MakePod().Name("other").UID("other-uid").Node("test").
+		Annotation("seccomp.security.alpha.kubernetes.io", "localhost/operator/default/z-seccomp.json").Obj()
+
+	// the profile is not known yet, the pod gets the default profile
+	sys.addPod(logger, pod)
+	sys.addPod(logger, other)
+	syscalls, unconfined := sys.getPodSyscalls(logger, pod)
+	assert.True(t, unconfined)
+	assert.EqualValues(t, len(spoResponseFull.Spec.Syscalls[0].Names), syscalls.Len())
+
+	profile := &v1beta1.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "new-seccomp", Namespace: "default"},
+		Spec: v1beta1.SeccompProfileSpec{
+			Syscalls: []*v1beta1.Syscall{{Action: "SCMP_ACT_ALLOW", Names: []string{"read", "write", "ptrace"}}},
+		},
+	}
+	sys.profileAdded(profile)
+	syscalls, unconfined = sys.getPodSyscalls(logger, pod)
+	assert.False(t, unconfined)
+	assert.EqualValues(t, 3, syscalls.Len())
+	_, hostSyscalls := sys.getHostSyscalls(logger, "test")
+	assert.True(t, hostSyscalls.Has("ptrace"))
+	assert.False(t, hostSyscalls.Has("bpf"))
+
+	updated := profile.DeepCopy()
+	updated.Spec.Syscalls[0].Names = []string{"read", "write"}
+	sys.profileUpdated(profile, updated)
+	syscalls, _ = sys.getPodSyscalls(logger, pod)
+	assert.EqualValues(t, 2, syscalls.Len())
+	_, hostSyscalls = sys.getHostSyscalls(logger, "test")
+	assert.False(t, hostSyscalls.Has("ptrace"))
+
+	// the pods not using the profile keep their cached syscalls
+	_, ok := sys.profiles.getPod(other.UID)
+	assert.True(t, ok)
+
+	sys.profileDeleted(updated)
+	_, unconfined = sys.getPodSyscalls(logger, pod)
+	assert.True(t, unconfined)
+
+	sys.podDeleted(pod)
+	_, ok = sys.profiles.getPod(pod.UID)
+	assert.False(t, ok)
+}
+
+func TestProfileCacheStalePod(t *testing.T) {
+	pc := newProfileCache()
+	profile := &v1beta1.SeccompProfile{ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "default"}}
+	ps := &podSyscalls{syscalls: sets.New("read"), profiles: sets.New(profileKey("default", "p"))}
+
+	// the profile changes while the pod syscalls are computed from its previous version
+	generation := pc.getGeneration()
+	pc.setProfile(profile)
+	pc.setPod("pod-uid", ps, generation)
+	_, ok := pc.getPod("pod-uid")
+	assert.False(t, ok)
+
+	pc.setPod("pod-uid", ps, pc.getGeneration())
+	_, ok = pc.getPod("pod-uid")
+	assert.True(t, ok)
+}
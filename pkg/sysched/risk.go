@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sysched
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// riskCatalogue assigns weights to the critical system calls, e.g. the ones with known CVEs.
+// The weights from the plugin args can be overridden by the ones in a ConfigMap, which is
+// watched so the catalogue can be updated without restarting the scheduler.
+// A nil riskCatalogue weighs all the system calls 1.
+type riskCatalogue struct {
+	lock sync.RWMutex
+	// static holds the weights from the plugin args
+	static map[string]int
+	// weights holds the effective weights, static ones merged with the ConfigMap ones
+	weights map[string]int
+}
+
+func newRiskCatalogue(syscallWeights map[string]int64) (*riskCatalogue, error) {
+	static := make(map[string]int, len(syscallWeights))
+	for syscall, weight := range syscallWeights {
+		if weight < 0 {
+			return nil, fmt.Errorf("invalid weight %d for syscall %q: must not be negative", weight, syscall)
+		}
+		static[syscall] = int(weight)
+	}
+	rc := &riskCatalogue{
+		static: static,
+	}
+	rc.update(klog.Background(), nil)
+	return rc, nil
+}
+
+// Weight returns the weight of a critical system call, and false if the system call is not critical.
+func (rc *riskCatalogue) Weight(syscall string) (int, bool) {
+	if rc == nil {
+		return 0, false
+	}
+	rc.lock.RLock()
+	defer rc.lock.RUnlock()
+	weight, ok := rc.weights[syscall]
+	return weight, ok
+}
+
+// update merges the weights in the ConfigMap data with the static ones.
+// Entries with invalid weights are ignored.
+func (rc *riskCatalogue) update(logger klog.Logger, data map[string]string) {
+	weights := make(map[string]int, len(rc.static)+len(data))
+	for syscall, weight := range rc.static {
+		weights[syscall] = weight
+	}
+	for syscall, value := range data {
+		weight, err := strconv.Atoi(value)
+		if err != nil || weight < 0 {
+			logger.Error(err, "Ignoring invalid syscall weight in the risk catalogue", "syscall", syscall, "weight", value)
+			continue
+		}
+		weights[syscall] = weight
+	}
+
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+	rc.weights = weights
+}
+
+func (rc *riskCatalogue) configMapAdded(logger klog.Logger, obj interface{}) {
+	cm, ok := obj.(*v1.ConfigMap)
+	if !ok {
+		return
+	}
+	logger.V(4).Info("Risk catalogue updated", "configMap", klog.KObj(cm))
+	rc.update(logger, cm.Data)
+}
+
+func (rc *riskCatalogue) configMapUpdated(logger klog.Logger, _, new interface{}) {
+	rc.configMapAdded(logger, new)
+}
+
+func (rc *riskCatalogue) configMapDeleted(logger klog.Logger, obj interface{}) {
+	logger.V(4).Info("Risk catalogue deleted, falling back to the plugin args")
+	rc.update(logger, nil)
+}
+
+// watch keeps the catalogue in sync with the ConfigMap named name in namespace. It returns once
+// the ConfigMap, if any, is loaded, or with an error if ctx is done first.
+func (rc *riskCatalogue) watch(ctx context.Context, clientSet kubernetes.Interface, namespace, name string) error {
+	logger := klog.FromContext(ctx)
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(clientSet, 0,
+		informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+		}),
+	)
+	informerFactory.Core().V1().ConfigMaps().Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				rc.configMapAdded(logger, obj)
+			},
+			UpdateFunc: func(old, new interface{}) {
+				rc.configMapUpdated(logger, old, new)
+			},
+			DeleteFunc: func(obj interface{}) {
+				rc.configMapDeleted(logger, obj)
+			},
+		},
+	)
+	informerFactory.Start(ctx.Done())
+	// the pods must not be scored before the weights of the ConfigMap are known
+	for _, synced := range informerFactory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return fmt.Errorf("failed to sync the risk catalogue ConfigMap %s/%s", namespace, name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sysched
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/klog/v2"
+)
+
+func TestNewRiskCatalogue(t *testing.T) {
+	_, err := newRiskCatalogue(map[string]int64{"ptrace": -1})
+	assert.NotNil(t, err)
+
+	rc, err := newRiskCatalogue(map[string]int64{"ptrace": 10})
+	assert.Nil(t, err)
+	w, ok := rc.Weight("ptrace")
+	assert.True(t, ok)
+	assert.EqualValues(t, 10, w)
+	_, ok = rc.Weight("read")
+	assert.False(t, ok)
+
+	var nilCatalogue *riskCatalogue
+	_, ok = nilCatalogue.Weight("ptrace")
+	assert.False(t, ok)
+}
+
+func TestRiskCatalogueConfigMap(t *testing.T) {
+	rc, err := newRiskCatalogue(map[string]int64{"ptrace": 10, "bpf": 5})
+	assert.Nil(t, err)
+
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "syscall-risk", Namespace: "default"},
+		Data: map[string]string{
+			"ptrace":  "50",
+			"unshare": "20",
+			"mount":   "invalid",
+			"chroot":  "-3",
+		},
+	}
+	rc.configMapAdded(klog.Background(), cm)
+
+	expected := map[string]int{"ptrace": 50, "bpf": 5, "unshare": 20}
+	for syscall, weight := range expected {
+		w, ok := rc.Weight(syscall)
+		assert.True(t, ok, syscall)
+		assert.EqualValues(t, weight, w, syscall)
+	}
+	for _, syscall := range []string{"mount", "chroot"} {
+		_, ok := rc.Weight(syscall)
+		assert.False(t, ok, syscall)
+	}
+
+	updated := cm.DeepCopy()
+	updated.Data = map[string]string{"bpf": "30"}
+	rc.configMapUpdated(klog.Background(), cm, updated)
+	w, _ := rc.Weight("bpf")
+	assert.EqualValues(t, 30, w)
+	w, _ = rc.Weight("ptrace")
+	assert.EqualValues(t, 10, w)
+	_, ok := rc.Weight("unshare")
+	assert.False(t, ok)
+
+	rc.configMapDeleted(klog.Background(), updated)
+	w, _ = rc.Weight("bpf")
+	assert.EqualValues(t, 5, w)
+}
+
+func TestRiskCatalogueWatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rc, err := newRiskCatalogue(map[string]int64{"ptrace": 10})
+	assert.Nil(t, err)
+	clientSet := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "syscall-risk", Namespace: "default"},
+		Data:       map[string]string{"ptrace": "50"},
+	})
+	assert.Nil(t, rc.watch(ctx, clientSet, "default", "syscall-risk"))
+	// the weights of the ConfigMap are known as soon as watch returns
+	w, _ := rc.Weight("ptrace")
+	assert.EqualValues(t, 50, w)
+
+	failing := fake.NewSimpleClientset()
+	failing.PrependReactor("list", "configmaps", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("unavailable")
+	})
+	timeoutCtx, timeoutCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer timeoutCancel()
+	assert.NotNil(t, rc.watch(timeoutCtx, failing, "default", "syscall-risk"))
+}
@@ -4,44 +4,51 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"net/http"
 	"path"
 	"strings"
 
-	"github.com/containers/common/pkg/seccomp"
 	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	clientscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 	"k8s.io/kubernetes/pkg/scheduler/framework"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/helper"
-	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimecache "sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 
 	pluginconfig "sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
 	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/debug"
+	"sigs.k8s.io/scheduler-plugins/pkg/metrics"
 )
 
 type SySched struct {
 	handle framework.Handle
-	client client.Client
-	// Maintain state of what pods on each node
-	// Cached state from SharedLister does not hold system wide info of pods
-	// scheduled by other schedulers
-	HostToPods map[string][]*v1.Pod
-	// Key: node name
-	// Value: set of system call names
-	HostSyscalls            map[string]sets.Set[string]
-	ExSAvg                  float64
-	ExSAvgCount             int64
+	// SeccompProfile objects and pod syscall sets
+	profiles *profileCache
+	// Maintain state of what pods on each node and the system calls they use
+	state                   *hostState
 	DefaultProfileNamespace string
 	DefaultProfileName      string
 	WeightedSyscallProfile  string
+	// filter out the nodes exceeding this extraneous syscall exposure, nil if there is no limit
+	maxExtraneousSyscalls *int64
+	// filter out the nodes where a pod would run alongside an unconfined pod
+	rejectUnconfinedColocation bool
+	// weights of the critical system calls, nil if none are configured
+	riskCatalogue *riskCatalogue
 }
 
+var _ framework.FilterPlugin = &SySched{}
 var _ framework.ScorePlugin = &SySched{}
+var _ debug.StateDumper = &SySched{}
+var _ debug.HandlerProvider = &SySched{}
 
 // Name is the name of the plugin used in Registry and configurations.
 const Name = "SySched"
@@ -49,14 +56,12 @@ const Name = "SySched"
 // SPO annotation string
 const SPO_ANNOTATION = "seccomp.security.alpha.kubernetes.io"
 
-func remove(s []*v1.Pod, i int) []*v1.Pod {
-	if len(s) == 0 {
-		return nil
-	}
-	s[i] = s[len(s)-1]
-
-	return s[:len(s)-1]
-}
+const (
+	// ErrReasonExtraneousSyscalls is the reason for a node exceeding the extraneous syscall limit.
+	ErrReasonExtraneousSyscalls = "node(s) exceed the extraneous system call limit"
+	// ErrReasonUnconfinedColocation is the reason for a node where the pod would run alongside an unconfined pod.
+	ErrReasonUnconfinedColocation = "node(s) would colocate the pod with an unconfined pod"
+)
 
 // extracts filename and namespace from the relative seccomp
 // profile path with the following formats
@@ -82,129 +87,166 @@ func parseNameNS(profilePath string) (string, string) {
 }
 
 // fetch the system call list from a SPO seccomp profile CR in a given namespace
+// The profile is read from the cache fed by the SeccompProfile informer
 func (sc *SySched) readSPOProfileCR(name string, namespace string) (sets.Set[string], error) {
-	syscalls := sets.New[string]()
-
 	if name == "" || namespace == "" {
-		return syscalls, nil
+		return sets.New[string](), nil
 	}
 
-	// extract a seccomp SPO profile CR using namespace and cr name
-	seccompProfile := &v1beta1.SeccompProfile{}
-
-	err := sc.client.Get(context.TODO(), client.ObjectKey{
-		Namespace: namespace,
-		Name:      name,
-	}, seccompProfile)
-
-	if err != nil {
-		return syscalls, err
-	}
-
-	syscallCategories := seccompProfile.Spec.Syscalls
-
-	// need to merge the syscalls in the syscall categories
-	// from multiple relevant actions, e.g., allow, log, notify
-	for _, element := range syscallCategories {
-		// NOTE: should we consider the other categories, e.g., notify, trace?
-		// SCMP_ACT_TRACE --> ActTrace, seccomp.ActNotify
-		if element.Action == seccomp.ActAllow || element.Action == seccomp.ActLog {
-			syscalls = syscalls.Union(sets.New[string](element.Names...))
-		}
+	syscalls, ok := sc.profiles.getProfile(namespace, name)
+	if !ok {
+		return sets.New[string](), fmt.Errorf("seccomp profile %s/%s not found", namespace, name)
 	}
 
 	return syscalls, nil
 }
 
-// obtains the system call list for a pod from the pod's seccomp profile
+// obtains the system call list for a pod from the seccomp profiles of its containers
 // SPO is used to generate and input the seccomp profile to a pod
-// If a pod does not have a SPO seccomp profile, then an unconfined
+// Containers with the RuntimeDefault profile get the default allow-list of the container runtime
+// If a container does not have a seccomp profile, then an unconfined
 // system call set is return for the pod
 func (sc *SySched) getSyscalls(logger klog.Logger, pod *v1.Pod) sets.Set[string] {
-	r := sets.New[string]()
-
-	// read the seccomp profile from the security context of a pod
-	podSC := pod.Spec.SecurityContext
+	r, _ := sc.getPodSyscalls(logger, pod)
+	return r
+}
 
-	if podSC != nil && podSC.SeccompProfile != nil && podSC.SeccompProfile.Type == "Localhost" {
-		if podSC.SeccompProfile.LocalhostProfile != nil {
-			profilePath := *podSC.SeccompProfile.LocalhostProfile
-			ns, name := parseNameNS(profilePath)
+// getPodSyscalls is like getSyscalls, but also tells whether the pod is unconfined,
+// i.e. whether its system call set is the one of the default profile
+// The result is cached until the pod is deleted or one of the profiles it is computed from changes
+func (sc *SySched) getPodSyscalls(logger klog.Logger, pod *v1.Pod) (sets.Set[string], bool) {
+	if ps, ok := sc.profiles.getPod(pod.UID); ok {
+		return ps.syscalls, ps.unconfined
+	}
 
-			if len(ns) > 0 && len(name) > 0 {
-				syscalls, err := sc.readSPOProfileCR(name, ns)
-				if err != nil {
-					logger.Error(err, "Failed to read syscall CR by parsing pod security context")
-				}
+	generation := sc.profiles.getGeneration()
+	ps := sc.computePodSyscalls(logger, pod)
+	sc.profiles.setPod(pod.UID, ps, generation)
+	return ps.syscalls, ps.unconfined
+}
 
-				if len(syscalls) > 0 {
-					r = r.Union(syscalls)
-				}
-			}
-		}
+func (sc *SySched) computePodSyscalls(logger klog.Logger, pod *v1.Pod) *podSyscalls {
+	ps := &podSyscalls{
+		syscalls: sets.New[string](),
+		// keep track of the profiles the syscalls are computed from, found or not,
+		// so the result can be invalidated when any of them changes
+		profiles: sets.New[string](),
 	}
 
-	// read the seccomp profile from container security context and merge them
-	for _, container := range pod.Spec.Containers {
-		conSC := container.SecurityContext
-		if conSC != nil && conSC.SeccompProfile != nil && conSC.SeccompProfile.Type == "Localhost" {
-			if conSC.SeccompProfile.LocalhostProfile != nil {
-				profilePath := *conSC.SeccompProfile.LocalhostProfile
-				ns, name := parseNameNS(profilePath)
-
-				if len(ns) > 0 && len(name) > 0 {
-					syscalls, err := sc.readSPOProfileCR(name, ns)
-					if err != nil {
-						logger.Error(err, "Failed to read syscall CR by parsing container security context")
-					}
-
-					if len(syscalls) > 0 {
-						r = r.Union(syscalls)
-					}
-				}
-			}
+	// the pod level profile applies to the containers which do not set their own
+	podProfile := podSeccompProfile(pod)
+
+	// the system calls of a pod are the union of the ones of all its containers,
+	// including the init and ephemeral containers
+	containers := 0
+	visit := func(name string, securityContext *v1.SecurityContext) {
+		containers++
+		profile := containerSeccompProfile(pod, name, securityContext)
+		if profile == nil {
+			profile = podProfile
 		}
+		sc.addProfileSyscalls(logger, ps, profile)
+	}
+	for i := range pod.Spec.InitContainers {
+		visit(pod.Spec.InitContainers[i].Name, pod.Spec.InitContainers[i].SecurityContext)
+	}
+	for i := range pod.Spec.Containers {
+		visit(pod.Spec.Containers[i].Name, pod.Spec.Containers[i].SecurityContext)
+	}
+	for i := range pod.Spec.EphemeralContainers {
+		visit(pod.Spec.EphemeralContainers[i].Name, pod.Spec.EphemeralContainers[i].SecurityContext)
+	}
+	if containers == 0 {
+		sc.addProfileSyscalls(logger, ps, podProfile)
 	}
 
-	// SPO seccomp profiles are sometimes automatically annotated to a pod
-	if pod.ObjectMeta.Annotations != nil {
-		// there could be multiple SPO seccomp profile annotations for a pod
-		// merge all profiles to obtain the syscal set for a pod
-		for k, v := range pod.ObjectMeta.Annotations {
-			// looks for annotation related to the seccomp
-			if strings.Contains(k, SPO_ANNOTATION) {
-				ns, name := parseNameNS(v)
-
-				if len(ns) > 0 && len(name) > 0 {
-					syscalls, err := sc.readSPOProfileCR(name, ns)
-
-					if err != nil {
-						logger.Error(err, "Failed to read syscall CR by parsing pod annotation")
-						continue
-					}
+	return ps
+}
 
-					if len(syscalls) > 0 {
-						r = r.Union(syscalls)
-					}
-				}
+// addProfileSyscalls merges the system calls allowed by a seccomp profile into the ones of the pod.
+// Containers without a seccomp profile, or with an unconfined one, or with a profile which cannot
+// be found, are considered unconfined, and get the system calls of the default profile.
+func (sc *SySched) addProfileSyscalls(logger klog.Logger, ps *podSyscalls, profile *v1.SeccompProfile) {
+	if profile != nil {
+		switch profile.Type {
+		case v1.SeccompProfileTypeRuntimeDefault:
+			ps.syscalls = ps.syscalls.Union(runtimeDefaultSyscalls)
+			return
+		case v1.SeccompProfileTypeLocalhost:
+			if profile.LocalhostProfile == nil {
+				break
+			}
+			ns, name := parseNameNS(*profile.LocalhostProfile)
+			if len(ns) == 0 || len(name) == 0 {
 				break
 			}
+			ps.profiles.Insert(profileKey(ns, name))
+			syscalls, err := sc.readSPOProfileCR(name, ns)
+			if err != nil {
+				logger.Error(err, "Failed to read syscall CR of the seccomp profile", "profile", *profile.LocalhostProfile)
+			}
+			if len(syscalls) > 0 {
+				ps.syscalls = ps.syscalls.Union(syscalls)
+				return
+			}
 		}
 	}
 
-	// if a pod does not have a seccomp profile specified, return the set of all syscalls
-	if len(r) == 0 {
-		syscalls, err := sc.readSPOProfileCR(sc.DefaultProfileName, sc.DefaultProfileNamespace)
-		if err != nil {
-			logger.Error(err, "Failed to read the CR of all syscalls")
-		}
+	// unconfined, return the set of all syscalls
+	if ps.unconfined {
+		return
+	}
+	ps.unconfined = true
+	ps.profiles.Insert(profileKey(sc.DefaultProfileNamespace, sc.DefaultProfileName))
+	syscalls, err := sc.readSPOProfileCR(sc.DefaultProfileName, sc.DefaultProfileNamespace)
+	if err != nil {
+		logger.Error(err, "Failed to read the CR of all syscalls")
+	}
+	ps.syscalls = ps.syscalls.Union(syscalls)
+}
 
-		if syscalls.Len() > 0 {
-			r = r.Union(syscalls)
+// podSeccompProfile returns the seccomp profile set at the pod level, from the security context
+// or, if not set there, from the annotations, SPO seccomp profiles being sometimes automatically
+// annotated to a pod. Returns nil if the pod sets no profile.
+func podSeccompProfile(pod *v1.Pod) *v1.SeccompProfile {
+	if podSC := pod.Spec.SecurityContext; podSC != nil && podSC.SeccompProfile != nil {
+		return podSC.SeccompProfile
+	}
+	if value, ok := pod.Annotations[v1.SeccompPodAnnotationKey]; ok {
+		return seccompProfileFromAnnotation(value)
+	}
+	for k, v := range pod.Annotations {
+		// looks for other annotations related to the seccomp, the first one wins
+		if strings.Contains(k, SPO_ANNOTATION) && !strings.HasPrefix(k, v1.SeccompContainerAnnotationKeyPrefix) {
+			return seccompProfileFromAnnotation(v)
 		}
 	}
+	return nil
+}
 
-	return r
+// containerSeccompProfile returns the seccomp profile set for a container, from its security context
+// or, if not set there, from the pod annotations. Returns nil if the container sets no profile.
+func containerSeccompProfile(pod *v1.Pod, name string, securityContext *v1.SecurityContext) *v1.SeccompProfile {
+	if securityContext != nil && securityContext.SeccompProfile != nil {
+		return securityContext.SeccompProfile
+	}
+	if value, ok := pod.Annotations[v1.SeccompContainerAnnotationKeyPrefix+name]; ok {
+		return seccompProfileFromAnnotation(value)
+	}
+	return nil
+}
+
+// seccompProfileFromAnnotation converts the value of a seccomp annotation to a seccomp profile.
+// Values which are not recognized are taken as the path of a localhost profile.
+func seccompProfileFromAnnotation(value string) *v1.SeccompProfile {
+	switch value {
+	case v1.SeccompProfileRuntimeDefault, v1.DeprecatedSeccompProfileDockerDefault:
+		return &v1.SeccompProfile{Type: v1.SeccompProfileTypeRuntimeDefault}
+	case v1.SeccompProfileNameUnconfined:
+		return &v1.SeccompProfile{Type: v1.SeccompProfileTypeUnconfined}
+	}
+	localhostProfile := strings.TrimPrefix(value, v1.SeccompLocalhostProfileNamePrefix)
+	return &v1.SeccompProfile{Type: v1.SeccompProfileTypeLocalhost, LocalhostProfile: &localhostProfile}
 }
 
 // Name returns name of the plugin. It is used in logs, etc.
@@ -212,30 +254,90 @@ func (sc *SySched) Name() string {
 	return Name
 }
 
+// DumpState records the system calls used by the pods of each node.
+func (sc *SySched) DumpState(state *debug.ProfileState) {
+	state.SySched = &debug.SySchedState{HostSyscalls: sc.state.hostSyscalls()}
+}
+
+// DebugHandlers serves the system calls used by the pods of each node.
+func (sc *SySched) DebugHandlers() map[string]http.Handler {
+	return map[string]http.Handler{
+		"syscalls": debug.JSONHandler(func() interface{} { return sc.state.hostSyscalls() }),
+	}
+}
+
 func (sc *SySched) calcScore(logger klog.Logger, syscalls sets.Set[string]) int {
-	// Currently, score is not adjusted based on critical/cve syscalls.
-	// NOTE: weight W is hardcoded for now
-	// TODO: add critical/cve syscalls
-	// TODO: adjust weight W for critical/cve syscalls
+	// Critical/cve syscalls found in the risk catalogue count for their weight,
+	// all the other syscalls count for 1.
 	totCrit := 0
-	W := 1
+	weightedCrit := 0
+	for syscall := range syscalls {
+		if w, ok := sc.riskCatalogue.Weight(syscall); ok {
+			totCrit++
+			weightedCrit += w
+		}
+	}
 
 	score := syscalls.Len() - totCrit
-	score = score + W*totCrit
+	score = score + weightedCrit
 	logger.V(10).Info("Score: ", "score", score, "tot_crit", totCrit)
 
 	return score
 }
 
+// Filter invoked at the filter extension point.
+// Checks the syscall isolation boundaries configured in the args, if any: a node is filtered out
+// if the pod would run alongside an unconfined pod, or if the extraneous syscall exposure of the pod,
+// or of any pod running on the node, would exceed the configured limit.
+func (sc *SySched) Filter(ctx context.Context, cs *framework.CycleState, pod *v1.Pod, nodeInfo *framework.NodeInfo) *framework.Status {
+	if sc.maxExtraneousSyscalls == nil && !sc.rejectUnconfinedColocation {
+		return nil
+	}
+	node := nodeInfo.Node()
+	if node == nil {
+		return framework.NewStatus(framework.Error, "node not found")
+	}
+
+	logger := klog.FromContext(ctx)
+	hostPods, hostSyscalls := sc.state.snapshot(node.Name)
+	if len(hostPods) == 0 {
+		return nil
+	}
+
+	podSyscalls, unconfined := sc.getPodSyscalls(logger, pod)
+	if sc.rejectUnconfinedColocation {
+		for _, hp := range hostPods {
+			if _, hostPodUnconfined := sc.getPodSyscalls(logger, hp.pod); unconfined || hostPodUnconfined {
+				logger.V(5).Info("Unconfined colocation", "pod", klog.KObj(pod), "hostPod", klog.KObj(hp.pod), "node", node.Name)
+				return framework.NewStatus(framework.Unschedulable, ErrReasonUnconfinedColocation)
+			}
+		}
+	}
+
+	if sc.maxExtraneousSyscalls == nil {
+		return nil
+	}
+	maxScore := *sc.maxExtraneousSyscalls
+
+	if score := sc.calcScore(logger, hostSyscalls.Difference(podSyscalls)); int64(score) > maxScore {
+		logger.V(5).Info("Extraneous syscalls exceeded", "pod", klog.KObj(pod), "node", node.Name, "score", score)
+		return framework.NewStatus(framework.Unschedulable, ErrReasonExtraneousSyscalls)
+	}
+
+	newHostSyscalls := hostSyscalls.Union(podSyscalls)
+	for _, hp := range hostPods {
+		if score := sc.calcScore(logger, newHostSyscalls.Difference(hp.syscalls)); int64(score) > maxScore {
+			logger.V(5).Info("Extraneous syscalls exceeded", "pod", klog.KObj(pod), "hostPod", klog.KObj(hp.pod), "node", node.Name, "score", score)
+			return framework.NewStatus(framework.Unschedulable, ErrReasonExtraneousSyscalls)
+		}
+	}
+
+	return nil
+}
+
 // Score invoked at the score extension point.
 func (sc *SySched) Score(ctx context.Context, cs *framework.CycleState, pod *v1.Pod, nodeName string) (int64, *framework.Status) {
 	logger := klog.FromContext(ctx)
-	// Read directly from API server because cached state in SnapSharedLister not always up-to-date
-	// especially during initial scheduler start.
-	node, err := sc.handle.ClientSet().CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
-	if err != nil {
-		return 0, nil
-	}
 
 	podSyscalls := sc.getSyscalls(logger, pod)
 
@@ -246,7 +348,7 @@ func (sc *SySched) Score(ctx context.Context, cs *framework.CycleState, pod *v1.
 		return math.MaxInt64, nil
 	}
 
-	_, hostSyscalls := sc.getHostSyscalls(logger, node.Name)
+	hostPods, hostSyscalls := sc.state.snapshot(nodeName)
 
 	// when a host or node does not have any pods
 	// running, the extraneous syscall score is zero
@@ -258,18 +360,17 @@ func (sc *SySched) Score(ctx context.Context, cs *framework.CycleState, pod *v1.
 	totalDiffs := sc.calcScore(logger, diffSyscalls)
 
 	// add the difference existing pods will see if new Pod is added into this host
-	newHostSyscalls := hostSyscalls.Clone()
-	newHostSyscalls = newHostSyscalls.Union(podSyscalls)
-	for _, p := range sc.HostToPods[node.Name] {
-		podSyscalls = sc.getSyscalls(logger, p)
-		diffSyscalls = newHostSyscalls.Difference(podSyscalls)
+	newHostSyscalls := hostSyscalls.Union(podSyscalls)
+	for _, hp := range hostPods {
+		diffSyscalls = newHostSyscalls.Difference(hp.syscalls)
 		totalDiffs += sc.calcScore(logger, diffSyscalls)
 	}
 
-	sc.ExSAvg = sc.ExSAvg + (float64(totalDiffs)-sc.ExSAvg)/float64(sc.ExSAvgCount)
-	sc.ExSAvgCount += 1
+	exSAvg := sc.state.recordExposure(totalDiffs)
+	metrics.SySchedExposure.Observe(float64(totalDiffs))
+	metrics.SySchedExposureAverage.Set(exSAvg)
 
-	logger.V(10).Info("ExSAvg: ", sc.ExSAvg)
+	logger.V(10).Info("ExSAvg: ", "exSAvg", exSAvg)
 	logger.V(10).Info("Score: ", "totalDiffs", totalDiffs, "pod", pod.Name, "node", nodeName)
 
 	return int64(totalDiffs), nil
@@ -291,74 +392,29 @@ func (sc *SySched) ScoreExtensions() framework.ScoreExtensions {
 
 func (sc *SySched) getHostSyscalls(logger klog.Logger, nodeName string) (int, sets.Set[string]) {
 	count := 0
-	h, ok := sc.HostSyscalls[nodeName]
-	if !ok {
+	_, h := sc.state.snapshot(nodeName)
+	if h == nil {
 		logger.V(5).Info(fmt.Sprintf("getHostSyscalls: no nodeName %s", nodeName))
 		return count, nil
 	}
 	return h.Len(), h
 }
 
-func (sc *SySched) updateHostSyscalls(logger klog.Logger, pod *v1.Pod) {
-	syscall := sc.getSyscalls(logger, pod)
-	sc.HostSyscalls[pod.Spec.NodeName] = sc.HostSyscalls[pod.Spec.NodeName].Union(syscall)
-}
-
 func (sc *SySched) addPod(logger klog.Logger, pod *v1.Pod) {
-	nodeName := pod.Spec.NodeName
-	name := pod.Name
-
-	_, ok := sc.HostToPods[nodeName]
-	if !ok {
-		sc.HostToPods[nodeName] = make([]*v1.Pod, 0)
-		sc.HostToPods[nodeName] = append(sc.HostToPods[nodeName], pod)
-		sc.HostSyscalls[nodeName] = sets.New[string]()
-		sc.updateHostSyscalls(logger, pod)
-		return
+	if sc.state.addPod(pod.Spec.NodeName, pod, sc.getSyscalls(logger, pod)) {
+		logger.V(5).Info("Pod added", "pod", klog.KObj(pod), "node", pod.Spec.NodeName)
 	}
-
-	for _, p := range sc.HostToPods[nodeName] {
-		if p.Name == name {
-			return
-		}
-	}
-
-	sc.HostToPods[nodeName] = append(sc.HostToPods[nodeName], pod)
-	sc.updateHostSyscalls(logger, pod)
-
-	return
-}
-
-func (sc *SySched) recomputeHostSyscalls(logger klog.Logger, pods []*v1.Pod) sets.Set[string] {
-	syscalls := sets.New[string]()
-
-	for _, p := range pods {
-		syscall := sc.getSyscalls(logger, p)
-		syscalls = syscalls.Union(syscall)
-	}
-
-	return syscalls
 }
 
 func (sc *SySched) removePod(logger klog.Logger, pod *v1.Pod) {
 	nodeName := pod.Spec.NodeName
 
-	_, ok := sc.HostToPods[nodeName]
-	if !ok {
-		logger.V(5).Info(fmt.Sprintf("removePod: Host %s not yet cached", nodeName))
+	if !sc.state.removePod(nodeName, pod) {
+		logger.V(5).Info(fmt.Sprintf("removePod: pod %s/%s not cached on host %s", pod.Namespace, pod.Name, nodeName))
 		return
 	}
-	for i, p := range sc.HostToPods[nodeName] {
-		if p.Name == pod.Name {
-			sc.HostToPods[nodeName] = remove(sc.HostToPods[nodeName], i)
-			sc.HostSyscalls[nodeName] = sc.recomputeHostSyscalls(logger, sc.HostToPods[nodeName])
-			c, _ := sc.getHostSyscalls(logger, nodeName)
-			logger.V(5).Info("remaining ", "syscalls", c, "node", nodeName)
-			return
-		}
-	}
-
-	return
+	c, _ := sc.getHostSyscalls(logger, nodeName)
+	logger.V(5).Info("remaining ", "syscalls", c, "node", nodeName)
 }
 
 func (sc *SySched) podAdded(obj interface{}) {
@@ -386,9 +442,66 @@ func (sc *SySched) podUpdated(old, new interface{}) {
 
 func (sc *SySched) podDeleted(obj interface{}) {
 	logger := klog.FromContext(context.TODO())
-	pod := obj.(*v1.Pod)
+	var pod *v1.Pod
+	switch t := obj.(type) {
+	case *v1.Pod:
+		pod = t
+	case cache.DeletedFinalStateUnknown:
+		var ok bool
+		if pod, ok = t.Obj.(*v1.Pod); !ok {
+			return
+		}
+	default:
+		return
+	}
 	logger.V(10).Info(fmt.Sprintf("POD DELETED: %s/%s", pod.Namespace, pod.Name))
 	sc.removePod(logger, pod)
+	sc.profiles.deletePod(pod.UID)
+}
+
+func (sc *SySched) profileAdded(obj interface{}) {
+	logger := klog.FromContext(context.TODO())
+	profile, ok := obj.(*v1beta1.SeccompProfile)
+	if !ok {
+		return
+	}
+	logger.V(10).Info(fmt.Sprintf("PROFILE UPDATED: %s/%s", profile.Namespace, profile.Name))
+	sc.refreshHostSyscalls(logger, sc.profiles.setProfile(profile))
+}
+
+func (sc *SySched) profileUpdated(_, new interface{}) {
+	sc.profileAdded(new)
+}
+
+func (sc *SySched) profileDeleted(obj interface{}) {
+	logger := klog.FromContext(context.TODO())
+	var profile *v1beta1.SeccompProfile
+	switch t := obj.(type) {
+	case *v1beta1.SeccompProfile:
+		profile = t
+	case cache.DeletedFinalStateUnknown:
+		var ok bool
+		if profile, ok = t.Obj.(*v1beta1.SeccompProfile); !ok {
+			return
+		}
+	default:
+		return
+	}
+	logger.V(10).Info(fmt.Sprintf("PROFILE DELETED: %s/%s", profile.Namespace, profile.Name))
+	sc.refreshHostSyscalls(logger, sc.profiles.deleteProfile(profile.Namespace, profile.Name))
+}
+
+// refreshHostSyscalls updates the syscalls the given pods, whose syscall sets changed,
+// are accounted with on their hosts
+func (sc *SySched) refreshHostSyscalls(logger klog.Logger, uids []types.UID) {
+	if len(uids) == 0 {
+		return
+	}
+	for nodeName, pods := range sc.state.podsWithUIDs(sets.New[types.UID](uids...)) {
+		for _, p := range pods {
+			sc.state.updatePodSyscalls(nodeName, p, sc.getSyscalls(logger, p))
+		}
+	}
 }
 
 // getArgs : returns the arguments for the SySchedArg plugin.
@@ -402,22 +515,40 @@ func getArgs(obj runtime.Object) (*pluginconfig.SySchedArgs, error) {
 }
 
 // New initializes a new plugin and returns it.
-func New(_ context.Context, obj runtime.Object, handle framework.Handle) (framework.Plugin, error) {
+func New(ctx context.Context, obj runtime.Object, handle framework.Handle) (framework.Plugin, error) {
 	sc := SySched{handle: handle}
-	sc.HostToPods = make(map[string][]*v1.Pod)
-	sc.HostSyscalls = make(map[string]sets.Set[string])
-	sc.ExSAvg = 0
-	sc.ExSAvgCount = 1
+	sc.state = newHostState()
 
 	args, err := getArgs(obj)
 	if err != nil {
 		return nil, err
 	}
+	if err := validation.ValidateSySchedArgs(nil, args); err != nil {
+		return nil, err
+	}
+	metrics.Register()
 
 	// get the default syscall profile CR namespace and name for all syscalls
 	sc.DefaultProfileNamespace = args.DefaultProfileNamespace
 	sc.DefaultProfileName = args.DefaultProfileName
 
+	sc.maxExtraneousSyscalls = args.MaxExtraneousSyscalls
+	sc.rejectUnconfinedColocation = args.RejectUnconfinedColocation
+
+	sc.riskCatalogue, err = newRiskCatalogue(args.SyscallWeights)
+	if err != nil {
+		return nil, err
+	}
+	if args.RiskCatalogueName != "" {
+		ns := args.RiskCatalogueNamespace
+		if ns == "" {
+			ns = args.DefaultProfileNamespace
+		}
+		if err := sc.riskCatalogue.watch(ctx, handle.ClientSet(), ns, args.RiskCatalogueName); err != nil {
+			return nil, err
+		}
+	}
+
 	scheme := runtime.NewScheme()
 	_ = clientscheme.AddToScheme(scheme)
 	_ = v1.AddToScheme(scheme)
@@ -425,12 +556,36 @@ func New(_ context.Context, obj runtime.Object, handle framework.Handle) (framew
 
 	v1beta1.AddToScheme(scheme)
 
-	client, err := client.New(handle.KubeConfig(), client.Options{Scheme: scheme})
+	// the SeccompProfile mapping is known upfront, so no discovery is needed
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(v1beta1.GroupVersion.WithKind("SeccompProfile"), meta.RESTScopeNamespace)
+
+	sc.profiles = newProfileCache()
+	profileInformerCache, err := ctrlruntimecache.New(handle.KubeConfig(), ctrlruntimecache.Options{Scheme: scheme, Mapper: mapper})
 	if err != nil {
 		return nil, err
 	}
 
-	sc.client = client
+	profileInformer, err := profileInformerCache.GetInformer(ctx, &v1beta1.SeccompProfile{})
+	if err != nil {
+		return nil, err
+	}
+	profileInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    sc.profileAdded,
+			UpdateFunc: sc.profileUpdated,
+			DeleteFunc: sc.profileDeleted,
+		},
+	)
+	go func() {
+		if err := profileInformerCache.Start(ctx); err != nil {
+			klog.FromContext(ctx).Error(err, "Failed to watch the seccomp profiles")
+		}
+	}()
+	// the pods must not be scheduled before the profiles their system calls come from are known
+	if !profileInformerCache.WaitForCacheSync(ctx) {
+		return nil, fmt.Errorf("failed to sync the seccomp profile cache")
+	}
 
 	podInformer := handle.SharedInformerFactory().Core().V1().Pods()
 
@@ -2,30 +2,29 @@ package sysched
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
-	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/informers"
 	clientsetfake "k8s.io/client-go/kubernetes/fake"
-	clientscheme "k8s.io/client-go/kubernetes/scheme"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	"k8s.io/kubernetes/pkg/scheduler/framework"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/defaultbinder"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/queuesort"
 	frameworkruntime "k8s.io/kubernetes/pkg/scheduler/framework/runtime"
 	st "k8s.io/kubernetes/pkg/scheduler/testing"
 	tf "k8s.io/kubernetes/pkg/scheduler/testing/framework"
-	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	pluginconfig "sigs.k8s.io/scheduler-plugins/apis/config"
-	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 )
 
@@ -96,57 +95,6 @@ var (
 	}
 )
 
-func TestRemove(t *testing.T) {
-	tests := []struct {
-		name        string
-		pods        []*v1.Pod
-		removeIndex []int
-		expectedLen int
-	}{
-		{
-			name: "Remove one",
-			pods: []*v1.Pod{
-				st.MakePod().Name("Pod-1").Obj(),
-				st.MakePod().Name("Pod-2").Obj(),
-				st.MakePod().Name("Pod-3").Obj(),
-			},
-			removeIndex: []int{1},
-			expectedLen: 2,
-		},
-		{
-			name: "Remove two",
-			pods: []*v1.Pod{
-				st.MakePod().Name("Pod-1").Obj(),
-				st.MakePod().Name("Pod-2").Obj(),
-				st.MakePod().Name("Pod-3").Obj(),
-			},
-			removeIndex: []int{1, 0},
-			expectedLen: 1,
-		},
-		{
-			name: "Remove all",
-			pods: []*v1.Pod{
-				st.MakePod().Name("Pod-1").Obj(),
-				st.MakePod().Name("Pod-2").Obj(),
-				st.MakePod().Name("Pod-3").Obj(),
-			},
-			removeIndex: []int{2, 1, 0},
-			expectedLen: 0,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			p := tt.pods
-			for _, i := range tt.removeIndex {
-				p = remove(p, i)
-			}
-			if len(p) != tt.expectedLen {
-				t.Errorf("remove pod failed %d", len(p))
-			}
-		})
-	}
-}
-
 func TestParseNameNS(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -174,6 +122,14 @@ func TestParseNameNS(t *testing.T) {
 	}
 }
 
+func mockProfileCache() *profileCache {
+	pc := newProfileCache()
+	for _, profile := range []*v1beta1.SeccompProfile{&spoResponse, &spoResponse1, &spoResponseFull} {
+		pc.setProfile(profile)
+	}
+	return pc
+}
+
 func mockSysched() (*SySched, error) {
 	// fake out the framework handle
 	ctx, cancel := context.WithCancel(context.Background())
@@ -183,29 +139,9 @@ func mockSysched() (*SySched, error) {
 	if err != nil {
 		return nil, err
 	}
-	scheme := runtime.NewScheme()
-	if err := v1.AddToScheme(scheme); err != nil {
-		return nil, err
-	}
-	if err := v1alpha1.AddToScheme(scheme); err != nil {
-		return nil, err
-	}
-	if err := v1beta1.AddToScheme(scheme); err != nil {
-		return nil, err
-	}
-	if err := clientscheme.AddToScheme(scheme); err != nil {
-		return nil, err
-	}
-
-	objs := []runtime.Object{&spoResponse, &spoResponse1, &spoResponseFull}
-	client := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
-
 	sys := SySched{handle: fr}
-	sys.client = client
-	sys.HostToPods = make(map[string][]*v1.Pod)
-	sys.HostSyscalls = make(map[string]sets.Set[string])
-	sys.ExSAvg = 0
-	sys.ExSAvgCount = 1
+	sys.profiles = mockProfileCache()
+	sys.state = newHostState()
 	sys.DefaultProfileName = "full-seccomp"
 	sys.DefaultProfileNamespace = "default"
 
@@ -321,21 +257,153 @@ func TestGetSyscalls(t *testing.T) {
 	}
 }
 
+func makeSeccompProfile(profileType v1.SeccompProfileType, localhostProfile string) *v1.SeccompProfile {
+	profile := &v1.SeccompProfile{Type: profileType}
+	if localhostProfile != "" {
+		profile.LocalhostProfile = &localhostProfile
+	}
+	return profile
+}
+
+func TestGetPodSyscalls(t *testing.T) {
+	sys, _ := mockSysched()
+	zSyscalls := sets.New[string](spoResponse.Spec.Syscalls[0].Names...)
+	xSyscalls := sets.New[string](spoResponse1.Spec.Syscalls[0].Names...)
+	fullSyscalls := sets.New[string](spoResponseFull.Spec.Syscalls[0].Names...)
+
+	tests := []struct {
+		name               string
+		pod                *v1.Pod
+		expected           sets.Set[string]
+		expectedUnconfined bool
+	}{
+		{
+			name: "RuntimeDefault pod",
+			pod: func() *v1.Pod {
+				pod := st.MakePod().Container("app").Obj()
+				pod.Spec.SecurityContext = &v1.PodSecurityContext{
+					SeccompProfile: makeSeccompProfile(v1.SeccompProfileTypeRuntimeDefault, ""),
+				}
+				return pod
+			}(),
+			expected: runtimeDefaultSyscalls,
+		},
+		{
+			name: "RuntimeDefault annotation",
+			pod: st.MakePod().Container("app").
+				Annotation(v1.SeccompPodAnnotationKey, v1.SeccompProfileRuntimeDefault).Obj(),
+			expected: runtimeDefaultSyscalls,
+		},
+		{
+			name: "Container profile overrides the pod profile",
+			pod: func() *v1.Pod {
+				pod := st.MakePod().Container("app").Container("sidecar").Obj()
+				pod.Spec.SecurityContext = &v1.PodSecurityContext{
+					SeccompProfile: makeSeccompProfile(v1.SeccompProfileTypeLocalhost, "operator/default/z-seccomp.json"),
+				}
+				pod.Spec.Containers[1].SecurityContext = &v1.SecurityContext{
+					SeccompProfile: makeSeccompProfile(v1.SeccompProfileTypeLocalhost, "operator/default/x-seccomp.json"),
+				}
+				return pod
+			}(),
+			expected: zSyscalls.Union(xSyscalls),
+		},
+		{
+			name: "Init container profile",
+			pod: func() *v1.Pod {
+				pod := st.MakePod().Container("app").Obj()
+				pod.Spec.InitContainers = []v1.Container{{Name: "init"}}
+				pod.Spec.SecurityContext = &v1.PodSecurityContext{
+					SeccompProfile: makeSeccompProfile(v1.SeccompProfileTypeRuntimeDefault, ""),
+				}
+				pod.Spec.InitContainers[0].SecurityContext = &v1.SecurityContext{
+					SeccompProfile: makeSeccompProfile(v1.SeccompProfileTypeLocalhost, "operator/default/x-seccomp.json"),
+				}
+				return pod
+			}(),
+			expected: runtimeDefaultSyscalls.Union(xSyscalls),
+		},
+		{
+			name: "Container annotation",
+			pod: st.MakePod().Container("app").Container("sidecar").
+				Annotation(v1.SeccompPodAnnotationKey, "localhost/operator/default/z-seccomp.json").
+				Annotation(v1.SeccompContainerAnnotationKeyPrefix+"con1", v1.SeccompProfileRuntimeDefault).Obj(),
+			expected: zSyscalls.Union(runtimeDefaultSyscalls),
+		},
+		{
+			name: "Ephemeral container without profile",
+			pod: func() *v1.Pod {
+				pod := st.MakePod().Container("app").Obj()
+				pod.Spec.Containers[0].SecurityContext = &v1.SecurityContext{
+					SeccompProfile: makeSeccompProfile(v1.SeccompProfileTypeLocalhost, "operator/default/z-seccomp.json"),
+				}
+				pod.Spec.EphemeralContainers = []v1.EphemeralContainer{{EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: "debug"}}}
+				return pod
+			}(),
+			expected:           zSyscalls.Union(fullSyscalls),
+			expectedUnconfined: true,
+		},
+		{
+			name: "Unconfined container",
+			pod: func() *v1.Pod {
+				pod := st.MakePod().Container("app").Obj()
+				pod.Spec.Containers[0].SecurityContext = &v1.SecurityContext{
+					SeccompProfile: makeSeccompProfile(v1.SeccompProfileTypeUnconfined, ""),
+				}
+				return pod
+			}(),
+			expected:           fullSyscalls,
+			expectedUnconfined: true,
+		},
+		{
+			name: "Missing localhost profile",
+			pod: st.MakePod().Container("app").
+				Annotation(v1.SeccompPodAnnotationKey, "localhost/operator/default/missing.json").Obj(),
+			expected:           fullSyscalls,
+			expectedUnconfined: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := klog.FromContext(context.TODO())
+			syscalls, unconfined := sys.getPodSyscalls(logger, tt.pod)
+			assert.True(t, tt.expected.Equal(syscalls), "unexpected syscalls %v", sets.List(syscalls.SymmetricDifference(tt.expected)))
+			assert.EqualValues(t, tt.expectedUnconfined, unconfined)
+		})
+	}
+}
+
 func TestCalcScore(t *testing.T) {
 	sys, _ := mockSysched()
 	tests := []struct {
-		name     string
-		syscalls sets.Set[string]
-		expected int
+		name           string
+		syscalls       sets.Set[string]
+		syscallWeights map[string]int64
+		expected       int
 	}{
 		{
 			name:     "Calculate exs score",
 			syscalls: sets.New[string](spoResponse.Spec.Syscalls[0].Names...),
 			expected: len(spoResponse.Spec.Syscalls[0].Names),
 		},
+		{
+			name:           "Calculate exs score with critical syscalls",
+			syscalls:       sets.New[string](spoResponse.Spec.Syscalls[0].Names...),
+			syscallWeights: map[string]int64{"chroot": 10, "setuid": 5, "ptrace": 20},
+			expected:       len(spoResponse.Spec.Syscalls[0].Names) - 2 + 15,
+		},
+		{
+			name:           "Calculate exs score with ignored syscalls",
+			syscalls:       sets.New[string]("read", "write", "chroot"),
+			syscallWeights: map[string]int64{"chroot": 0},
+			expected:       2,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			rc, err := newRiskCatalogue(tt.syscallWeights)
+			assert.Nil(t, err)
+			sys.riskCatalogue = rc
 			logger := klog.FromContext(context.TODO())
 			score := sys.calcScore(logger, tt.syscalls)
 			assert.EqualValues(t, tt.expected, score)
@@ -362,29 +430,9 @@ func TestScore(t *testing.T) {
 		t.Error(err)
 	}
 
-	scheme := runtime.NewScheme()
-	if err := v1.AddToScheme(scheme); err != nil {
-		t.Error(err)
-	}
-	if err := v1alpha1.AddToScheme(scheme); err != nil {
-		t.Error(err)
-	}
-	if err := v1beta1.AddToScheme(scheme); err != nil {
-		t.Error(err)
-	}
-	if err := clientscheme.AddToScheme(scheme); err != nil {
-		t.Error(err)
-	}
-
-	objs := []runtime.Object{&spoResponse, &spoResponse1, &spoResponseFull}
-	client := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
-
 	sys := SySched{handle: fr}
-	sys.client = client
-	sys.HostToPods = make(map[string][]*v1.Pod)
-	sys.HostSyscalls = make(map[string]sets.Set[string])
-	sys.ExSAvg = 0
-	sys.ExSAvgCount = 0
+	sys.profiles = mockProfileCache()
+	sys.state = newHostState()
 
 	sys.addPod(logger, pod)
 
@@ -414,6 +462,94 @@ func TestScore(t *testing.T) {
 	}
 }
 
+func TestFilter(t *testing.T) {
+	confinedPod := st.MakePod().Annotation("seccomp.security.alpha.kubernetes.io",
+		"localhost/operator/default/z-seccomp.json").Name("confined").Node("confined-node").Obj()
+	unconfinedPod := st.MakePod().Name("unconfined").Node("unconfined-node").Obj()
+
+	tests := []struct {
+		name                       string
+		pod                        *v1.Pod
+		nodeName                   string
+		maxExtraneousSyscalls      *int64
+		rejectUnconfinedColocation bool
+		expected                   *framework.Status
+	}{
+		{
+			name: "Filter disabled",
+			pod: st.MakePod().Annotation("seccomp.security.alpha.kubernetes.io",
+				"localhost/operator/default/x-seccomp.json").Name("pod").Obj(),
+			nodeName: "confined-node",
+		},
+		{
+			name: "Extraneous syscalls within the limit",
+			pod: st.MakePod().Annotation("seccomp.security.alpha.kubernetes.io",
+				"localhost/operator/default/x-seccomp.json").Name("pod").Obj(),
+			nodeName:              "confined-node",
+			maxExtraneousSyscalls: ptr.To[int64](1),
+		},
+		{
+			name: "Extraneous syscalls exceeding the limit",
+			pod: st.MakePod().Annotation("seccomp.security.alpha.kubernetes.io",
+				"localhost/operator/default/x-seccomp.json").Name("pod").Obj(),
+			nodeName:              "confined-node",
+			maxExtraneousSyscalls: ptr.To[int64](0),
+			expected:              framework.NewStatus(framework.Unschedulable, ErrReasonExtraneousSyscalls),
+		},
+		{
+			name: "Same syscalls with no extraneous syscall allowed",
+			pod: st.MakePod().Annotation("seccomp.security.alpha.kubernetes.io",
+				"localhost/operator/default/z-seccomp.json").Name("pod").Obj(),
+			nodeName:              "confined-node",
+			maxExtraneousSyscalls: ptr.To[int64](0),
+		},
+		{
+			name:                  "Empty node",
+			pod:                   st.MakePod().Name("pod").Obj(),
+			nodeName:              "empty-node",
+			maxExtraneousSyscalls: ptr.To[int64](0),
+		},
+		{
+			name:                       "Unconfined pod next to a confined pod",
+			pod:                        st.MakePod().Name("pod").Obj(),
+			nodeName:                   "confined-node",
+			rejectUnconfinedColocation: true,
+			expected:                   framework.NewStatus(framework.Unschedulable, ErrReasonUnconfinedColocation),
+		},
+		{
+			name: "Confined pod next to an unconfined pod",
+			pod: st.MakePod().Annotation("seccomp.security.alpha.kubernetes.io",
+				"localhost/operator/default/z-seccomp.json").Name("pod").Obj(),
+			nodeName:                   "unconfined-node",
+			rejectUnconfinedColocation: true,
+			expected:                   framework.NewStatus(framework.Unschedulable, ErrReasonUnconfinedColocation),
+		},
+		{
+			name: "Confined pods colocated",
+			pod: st.MakePod().Annotation("seccomp.security.alpha.kubernetes.io",
+				"localhost/operator/default/x-seccomp.json").Name("pod").Obj(),
+			nodeName:                   "confined-node",
+			rejectUnconfinedColocation: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sys, err := mockSysched()
+			assert.Nil(t, err)
+			logger := klog.FromContext(context.TODO())
+			sys.addPod(logger, confinedPod)
+			sys.addPod(logger, unconfinedPod)
+			sys.maxExtraneousSyscalls = tt.maxExtraneousSyscalls
+			sys.rejectUnconfinedColocation = tt.rejectUnconfinedColocation
+
+			nodeInfo := framework.NewNodeInfo()
+			nodeInfo.SetNode(st.MakeNode().Name(tt.nodeName).Obj())
+			status := sys.Filter(context.Background(), framework.NewCycleState(), tt.pod, nodeInfo)
+			assert.EqualValues(t, tt.expected, status)
+		})
+	}
+}
+
 func TestNormalizeScore(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -486,7 +622,6 @@ func TestGetHostSyscalls(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			logger := klog.FromContext(context.TODO())
 			sys, _ := mockSysched()
-			sys.ExSAvgCount = 0
 
 			for i := range tt.pods {
 				sys.addPod(logger, tt.pods[i])
@@ -499,7 +634,6 @@ func TestGetHostSyscalls(t *testing.T) {
 }
 
 func TestUpdateHostSyscalls(t *testing.T) {
-	utilruntime.Must(v1beta1.AddToScheme(clientscheme.Scheme))
 	tests := []struct {
 		name     string
 		nodes    []*v1.Node
@@ -514,11 +648,13 @@ func TestUpdateHostSyscalls(t *testing.T) {
 				st.MakeNode().Name("test1").Obj(),
 			},
 			basePods: []*v1.Pod{
-				st.MakePod().Annotation("seccomp.security.alpha.kubernetes.io",
+				st.MakePod().Name("pod1").Annotation("seccomp.security.alpha.kubernetes.io",
+					"localhost/operator/default/z-seccomp.json").Node("test").Obj(),
+				st.MakePod().Name("pod2").Annotation("seccomp.security.alpha.kubernetes.io",
 					"localhost/operator/default/z-seccomp.json").Node("test").Obj(),
 			},
 			newPods: []*v1.Pod{
-				st.MakePod().Annotation("seccomp.security.alpha.kubernetes.io",
+				st.MakePod().Name("pod1").Annotation("seccomp.security.alpha.kubernetes.io",
 					"localhost/operator/default/x-seccomp.json").Node("test").Obj(),
 			},
 			expected: sets.New[string](spoResponse.Spec.Syscalls[0].Names...).Union(sets.New[string](spoResponse1.Spec.Syscalls[0].Names...)).Len(),
@@ -541,36 +677,16 @@ func TestUpdateHostSyscalls(t *testing.T) {
 				t.Error(err)
 			}
 
-			scheme := runtime.NewScheme()
-			if err := v1.AddToScheme(scheme); err != nil {
-				t.Error(err)
-			}
-			if err := v1alpha1.AddToScheme(scheme); err != nil {
-				t.Error(err)
-			}
-			if err := v1beta1.AddToScheme(scheme); err != nil {
-				t.Error(err)
-			}
-			if err := clientscheme.AddToScheme(scheme); err != nil {
-				t.Error(err)
-			}
-
-			objs := []runtime.Object{&spoResponse, &spoResponse1, &spoResponseFull}
-			client := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
-
 			sys := SySched{handle: fr}
-			sys.client = client
-			sys.HostToPods = make(map[string][]*v1.Pod)
-			sys.HostSyscalls = make(map[string]sets.Set[string])
-			sys.ExSAvg = 0
-			sys.ExSAvgCount = 0
+			sys.profiles = mockProfileCache()
+			sys.state = newHostState()
 
 			for i := range tt.basePods {
 				sys.addPod(logger, tt.basePods[i])
 			}
 
 			for i := range tt.newPods {
-				sys.updateHostSyscalls(logger, tt.newPods[i])
+				sys.state.updatePodSyscalls(tt.newPods[i].Spec.NodeName, tt.newPods[i], sys.getSyscalls(logger, tt.newPods[i]))
 			}
 			sc, _ := sys.getHostSyscalls(logger, "test")
 			assert.EqualValues(t, tt.expected, sc)
@@ -602,38 +718,8 @@ func TestAddPod(t *testing.T) {
 			for i := range tt.pods {
 				sys.addPod(logger, tt.pods[i])
 			}
-			for i := range sys.HostToPods["test"] {
-				assert.EqualValues(t, tt.pods[i], sys.HostToPods["test"][i])
-			}
-			assert.EqualValues(t, tt.expected, len(sys.HostSyscalls["test"]))
-		})
-	}
-}
-
-func TestRecomputeHostSyscalls(t *testing.T) {
-	sys, _ := mockSysched()
-	tests := []struct {
-		name     string
-		pods     []*v1.Pod
-		expected int
-	}{
-		{
-			name: "Recompute 2 pods",
-			pods: []*v1.Pod{
-				st.MakePod().Annotation("seccomp.security.alpha.kubernetes.io",
-					"localhost/operator/default/z-seccomp.json").Node("test").Obj(),
-				st.MakePod().Annotation("seccomp.security.alpha.kubernetes.io",
-					"localhost/operator/default/x-seccomp.json").Node("test").Obj(),
-			},
-			expected: sets.New[string](spoResponse.Spec.Syscalls[0].Names...).Union(sets.New[string](spoResponse1.Spec.Syscalls[0].Names...)).Len(),
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			logger := klog.FromContext(context.TODO())
-			syscalls := sys.recomputeHostSyscalls(logger, tt.pods)
-			assert.EqualValues(t, tt.expected, len(syscalls))
+			assert.ElementsMatch(t, tt.pods, sys.state.getPods("test"))
+			assert.EqualValues(t, tt.expected, hostSyscallCount(sys, "test"))
 		})
 	}
 }
@@ -673,8 +759,8 @@ func TestRemovePod(t *testing.T) {
 				sys.removePod(logger, tt.removePods[i])
 			}
 
-			assert.EqualValues(t, tt.expectedPodNum, len(sys.HostToPods["test"]))
-			assert.EqualValues(t, tt.expected, len(sys.HostSyscalls["test"]))
+			assert.EqualValues(t, tt.expectedPodNum, len(sys.state.getPods("test")))
+			assert.EqualValues(t, tt.expected, hostSyscallCount(sys, "test"))
 		})
 	}
 }
@@ -707,8 +793,8 @@ func TestPodAdded(t *testing.T) {
 				sys.podAdded(tt.basePods[i])
 			}
 
-			assert.EqualValues(t, tt.expectedPodNum, len(sys.HostToPods["test"]))
-			assert.EqualValues(t, tt.expected, len(sys.HostSyscalls["test"]))
+			assert.EqualValues(t, tt.expectedPodNum, len(sys.state.getPods("test")))
+			assert.EqualValues(t, tt.expected, hostSyscallCount(sys, "test"))
 		})
 	}
 }
@@ -748,8 +834,8 @@ func TestPodUpdated(t *testing.T) {
 				sys.podUpdated(tt.updatedPods[i], nil)
 			}
 
-			assert.EqualValues(t, tt.expectedPodNum, len(sys.HostToPods["test"]))
-			assert.EqualValues(t, tt.expected, len(sys.HostSyscalls["test"]))
+			assert.EqualValues(t, tt.expectedPodNum, len(sys.state.getPods("test")))
+			assert.EqualValues(t, tt.expected, hostSyscallCount(sys, "test"))
 		})
 	}
 }
@@ -789,8 +875,8 @@ func TestPodDeleted(t *testing.T) {
 				sys.podDeleted(tt.deletedPods[i])
 			}
 
-			assert.EqualValues(t, tt.expectedPodNum, len(sys.HostToPods["test"]))
-			assert.EqualValues(t, tt.expected, len(sys.HostSyscalls["test"]))
+			assert.EqualValues(t, tt.expectedPodNum, len(sys.state.getPods("test")))
+			assert.EqualValues(t, tt.expected, hostSyscallCount(sys, "test"))
 		})
 	}
 }
@@ -805,13 +891,37 @@ func TestGetArgs(t *testing.T) {
 	assert.EqualValues(t, &args, retargs)
 }
 
+// newProfileServer serves the given seccomp profiles to the profile informer, as the API server would.
+func newProfileServer(t *testing.T, profiles ...v1beta1.SeccompProfile) *httptest.Server {
+	list := &v1beta1.SeccompProfileList{
+		TypeMeta: metav1.TypeMeta{APIVersion: v1beta1.GroupVersion.String(), Kind: "SeccompProfileList"},
+		ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+		Items:    profiles,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if req.URL.Query().Get("watch") == "true" {
+			// the profiles never change: hold the watch until the informer stops
+			w.(http.Flusher).Flush()
+			<-req.Context().Done()
+			return
+		}
+		if err := json.NewEncoder(w).Encode(list); err != nil {
+			t.Error(err)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
 func TestNew(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	server := newProfileServer(t, spoResponse1)
 	fakeclient := clientsetfake.NewSimpleClientset()
 	fr, err := tf.NewFramework(ctx, registeredPlugins, Name,
 		frameworkruntime.WithInformerFactory(informers.NewSharedInformerFactory(fakeclient, 0)),
-		frameworkruntime.WithKubeConfig(&restclient.Config{}),
+		frameworkruntime.WithKubeConfig(&restclient.Config{Host: server.URL}),
 		frameworkruntime.WithClientSet(fakeclient))
 	if err != nil {
 		t.Error(err)
@@ -825,4 +935,8 @@ func TestNew(t *testing.T) {
 	sys, err := New(ctx, &args, fr)
 	assert.Nil(t, err)
 	assert.NotNil(t, sys)
+
+	// the profiles are known as soon as the plugin is created
+	_, ok := sys.(*SySched).profiles.getProfile("default", "x-seccomp")
+	assert.True(t, ok)
 }
@@ -18,6 +18,7 @@ package trimaran
 
 import (
 	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
@@ -27,6 +28,8 @@ import (
 	"k8s.io/klog/v2"
 
 	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/pkg/debug"
+	pluginmetrics "sigs.k8s.io/scheduler-plugins/pkg/metrics"
 )
 
 const (
@@ -69,6 +72,7 @@ func NewCollector(logger klog.Logger, trimaranSpec *pluginConfig.TrimaranSpec) (
 		client, _ = loadwatcherapi.NewLibraryClient(opts)
 	}
 
+	pluginmetrics.Register()
 	collector := &Collector{
 		client: client,
 	}
@@ -115,6 +119,21 @@ func (collector *Collector) GetNodeMetrics(logger klog.Logger, nodeName string)
 	return allMetrics.Data.NodeMetricsMap[nodeName].Metrics, allMetrics
 }
 
+// DumpState : record the metrics last collected in the state of the profile of the plugin <name>
+func (collector *Collector) DumpState(name string, state *debug.ProfileState) {
+	if state.Trimaran == nil {
+		state.Trimaran = make(map[string]*debug.TrimaranState)
+	}
+	state.Trimaran[name] = &debug.TrimaranState{Metrics: *collector.getAllMetrics()}
+}
+
+// DebugHandlers : serve the metrics last collected
+func (collector *Collector) DebugHandlers() map[string]http.Handler {
+	return map[string]http.Handler{
+		"metrics": debug.JSONHandler(func() interface{} { return collector.getAllMetrics() }),
+	}
+}
+
 // checkSpecs : check trimaran specs
 func checkSpecs(trimaranSpec *pluginConfig.TrimaranSpec) error {
 	if trimaranSpec.WatcherAddress == "" {
@@ -139,5 +158,8 @@ func (collector *Collector) updateMetrics(logger klog.Logger) error {
 	collector.mu.Lock()
 	collector.metrics = *metrics
 	collector.mu.Unlock()
+	if metrics.Timestamp > 0 {
+		pluginmetrics.TrimaranCollectorLag.Set(time.Since(time.Unix(metrics.Timestamp, 0)).Seconds())
+	}
 	return nil
 }
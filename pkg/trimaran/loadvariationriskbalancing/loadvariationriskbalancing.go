@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"net/http"
 
 	"github.com/paypal/load-watcher/pkg/watcher"
 
@@ -33,6 +34,9 @@ import (
 	"k8s.io/kubernetes/pkg/scheduler/framework"
 
 	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
+	"sigs.k8s.io/scheduler-plugins/pkg/debug"
+	pluginmetrics "sigs.k8s.io/scheduler-plugins/pkg/metrics"
 	"sigs.k8s.io/scheduler-plugins/pkg/trimaran"
 )
 
@@ -50,6 +54,8 @@ type LoadVariationRiskBalancing struct {
 }
 
 var _ framework.ScorePlugin = &LoadVariationRiskBalancing{}
+var _ debug.StateDumper = &LoadVariationRiskBalancing{}
+var _ debug.HandlerProvider = &LoadVariationRiskBalancing{}
 
 // New : create an instance of a LoadVariationRiskBalancing plugin
 func New(ctx context.Context, obj runtime.Object, handle framework.Handle) (framework.Plugin, error) {
@@ -60,6 +66,9 @@ func New(ctx context.Context, obj runtime.Object, handle framework.Handle) (fram
 	if !ok {
 		return nil, fmt.Errorf("want args to be of type LoadVariationRiskBalancingArgs, got %T", obj)
 	}
+	if err := validation.ValidateLoadVariationRiskBalancingArgs(nil, args); err != nil {
+		return nil, err
+	}
 	collector, err := trimaran.NewCollector(logger, &args.TrimaranSpec)
 	if err != nil {
 		return nil, err
@@ -127,12 +136,23 @@ func (pl *LoadVariationRiskBalancing) Name() string {
 	return Name
 }
 
+// DumpState : record the metrics last collected
+func (pl *LoadVariationRiskBalancing) DumpState(state *debug.ProfileState) {
+	pl.collector.DumpState(Name, state)
+}
+
+// DebugHandlers : serve the metrics last collected
+func (pl *LoadVariationRiskBalancing) DebugHandlers() map[string]http.Handler {
+	return pl.collector.DebugHandlers()
+}
+
 // ScoreExtensions : an interface for Score extended functionality
 func (pl *LoadVariationRiskBalancing) ScoreExtensions() framework.ScoreExtensions {
 	return pl
 }
 
 // NormalizeScore : normalize scores
-func (pl *LoadVariationRiskBalancing) NormalizeScore(context.Context, *framework.CycleState, *v1.Pod, framework.NodeScoreList) *framework.Status {
+func (pl *LoadVariationRiskBalancing) NormalizeScore(_ context.Context, _ *framework.CycleState, _ *v1.Pod, scores framework.NodeScoreList) *framework.Status {
+	pluginmetrics.ObserveTrimaranScores(Name, scores)
 	return nil
 }
@@ -114,19 +114,20 @@ func TestNew(t *testing.T) {
 	assert.NotNil(t, p)
 	assert.Nil(t, err)
 
-	// bad arguments will be substituted by default values
+	// bad arguments are rejected
 	badArgs := pluginConfig.LoadVariationRiskBalancingArgs{
 		TrimaranSpec:       pluginConfig.TrimaranSpec{WatcherAddress: server.URL},
 		SafeVarianceMargin: -5,
 	}
 	badp, err := New(ctx, &badArgs, fh)
-	assert.NotNil(t, badp)
-	assert.Nil(t, err)
+	assert.Nil(t, badp)
+	assert.ErrorContains(t, err, "safeVarianceMargin: Invalid value: -5")
 
+	badArgs.SafeVarianceMargin = 1
 	badArgs.SafeVarianceSensitivity = -1
 	badp, err = New(ctx, &badArgs, fh)
-	assert.NotNil(t, badp)
-	assert.Nil(t, err)
+	assert.Nil(t, badp)
+	assert.ErrorContains(t, err, "safeVarianceSensitivity: Invalid value: -1")
 }
 
 func TestScore(t *testing.T) {
@@ -25,6 +25,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"net/http"
 
 	"github.com/paypal/load-watcher/pkg/watcher"
 
@@ -35,6 +36,9 @@ import (
 
 	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
 	pluginv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
+	"sigs.k8s.io/scheduler-plugins/pkg/debug"
+	pluginmetrics "sigs.k8s.io/scheduler-plugins/pkg/metrics"
 	"sigs.k8s.io/scheduler-plugins/pkg/trimaran"
 )
 
@@ -66,6 +70,9 @@ func New(ctx context.Context, obj runtime.Object, handle framework.Handle) (fram
 	if !ok {
 		return nil, fmt.Errorf("want args to be of type LowRiskOverCommitmentArgs, got %T", obj)
 	}
+	if err := validation.ValidateLowRiskOverCommitmentArgs(nil, args); err != nil {
+		return nil, err
+	}
 	collector, err := trimaran.NewCollector(logger, &args.TrimaranSpec)
 	if err != nil {
 		return nil, err
@@ -145,13 +152,24 @@ func (pl *LowRiskOverCommitment) Name() string {
 	return Name
 }
 
+// DumpState : record the metrics last collected
+func (pl *LowRiskOverCommitment) DumpState(state *debug.ProfileState) {
+	pl.collector.DumpState(Name, state)
+}
+
+// DebugHandlers : serve the metrics last collected
+func (pl *LowRiskOverCommitment) DebugHandlers() map[string]http.Handler {
+	return pl.collector.DebugHandlers()
+}
+
 // ScoreExtensions : an interface for Score extended functionality
 func (pl *LowRiskOverCommitment) ScoreExtensions() framework.ScoreExtensions {
 	return pl
 }
 
 // NormalizeScore : normalize scores
-func (pl *LowRiskOverCommitment) NormalizeScore(context.Context, *framework.CycleState, *v1.Pod, framework.NodeScoreList) *framework.Status {
+func (pl *LowRiskOverCommitment) NormalizeScore(_ context.Context, _ *framework.CycleState, _ *v1.Pod, scores framework.NodeScoreList) *framework.Status {
+	pluginmetrics.ObserveTrimaranScores(Name, scores)
 	return nil
 }
 
@@ -117,18 +117,25 @@ func TestLowRiskOverCommitment_New(t *testing.T) {
 	assert.NotNil(t, p)
 	assert.Nil(t, err)
 
-	// bad arguments will be substituted by default values
+	// bad arguments are rejected
 	badArgs := pluginConfig.LowRiskOverCommitmentArgs{
 		TrimaranSpec:        pluginConfig.TrimaranSpec{WatcherAddress: server.URL},
 		SmoothingWindowSize: -5,
 	}
 	badp, err := New(ctx, &badArgs, fh)
-	assert.NotNil(t, badp)
-	assert.Nil(t, err)
+	assert.Nil(t, badp)
+	assert.ErrorContains(t, err, "smoothingWindowSize: Invalid value: -5")
 
-	badArgs.RiskLimitWeights = nil
+	badArgs.SmoothingWindowSize = 5
+	badArgs.RiskLimitWeights = map[v1.ResourceName]float64{v1.ResourceCPU: 2}
 	badp, err = New(ctx, &badArgs, fh)
-	assert.NotNil(t, badp)
+	assert.Nil(t, badp)
+	assert.ErrorContains(t, err, "riskLimitWeights[cpu]: Invalid value: 2")
+
+	// missing risk limit weights fall back to the default ones
+	badArgs.RiskLimitWeights = nil
+	p, err = New(ctx, &badArgs, fh)
+	assert.NotNil(t, p)
 	assert.Nil(t, err)
 }
 
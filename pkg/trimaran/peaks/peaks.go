@@ -26,6 +26,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"net/http"
 	"os"
 	"strings"
 
@@ -39,6 +40,9 @@ import (
 	schedutil "k8s.io/kubernetes/pkg/scheduler/util"
 
 	"sigs.k8s.io/scheduler-plugins/apis/config"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
+	"sigs.k8s.io/scheduler-plugins/pkg/debug"
+	pluginmetrics "sigs.k8s.io/scheduler-plugins/pkg/metrics"
 	"sigs.k8s.io/scheduler-plugins/pkg/trimaran"
 )
 
@@ -53,11 +57,23 @@ type Peaks struct {
 }
 
 var _ framework.ScorePlugin = &Peaks{}
+var _ debug.StateDumper = &Peaks{}
+var _ debug.HandlerProvider = &Peaks{}
 
 func (pl *Peaks) Name() string {
 	return Name
 }
 
+// DumpState records the metrics last collected.
+func (pl *Peaks) DumpState(state *debug.ProfileState) {
+	pl.collector.DumpState(Name, state)
+}
+
+// DebugHandlers serves the metrics last collected.
+func (pl *Peaks) DebugHandlers() map[string]http.Handler {
+	return pl.collector.DebugHandlers()
+}
+
 func initNodePowerModels(powerModel map[string]config.PowerModel) error {
 	fmt.Printf("args power model : %+v\n", powerModel)
 	if len(powerModel) > 0 {
@@ -83,6 +99,9 @@ func New(ctx context.Context, obj runtime.Object, handle framework.Handle) (fram
 	if !ok {
 		return nil, fmt.Errorf("want args to be of type PeaksArgs, got %T", obj)
 	}
+	if err := validation.ValidatePeaksArgs(nil, args); err != nil {
+		return nil, err
+	}
 	collector, err := trimaran.NewCollector(logger, &config.TrimaranSpec{WatcherAddress: args.WatcherAddress})
 	if err != nil {
 		return nil, err
@@ -169,6 +188,8 @@ func (pl *Peaks) ScoreExtensions() framework.ScoreExtensions {
 }
 
 func (pl *Peaks) NormalizeScore(ctx context.Context, state *framework.CycleState, pod *v1.Pod, scores framework.NodeScoreList) *framework.Status {
+	// The scores are normalized in place, so they are observed on return.
+	defer pluginmetrics.ObserveTrimaranScores(Name, scores)
 	minCost, maxCost := getMinMaxScores(scores)
 	if minCost == 0 && maxCost == 0 {
 		return framework.NewStatus(framework.Success, "")
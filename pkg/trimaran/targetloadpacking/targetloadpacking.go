@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"net/http"
 	"strconv"
 
 	"github.com/paypal/load-watcher/pkg/watcher"
@@ -37,6 +38,9 @@ import (
 
 	pluginConfig "sigs.k8s.io/scheduler-plugins/apis/config"
 	cfgv1 "sigs.k8s.io/scheduler-plugins/apis/config/v1"
+	"sigs.k8s.io/scheduler-plugins/apis/config/validation"
+	"sigs.k8s.io/scheduler-plugins/pkg/debug"
+	pluginmetrics "sigs.k8s.io/scheduler-plugins/pkg/metrics"
 	"sigs.k8s.io/scheduler-plugins/pkg/trimaran"
 )
 
@@ -60,6 +64,8 @@ type TargetLoadPacking struct {
 }
 
 var _ framework.ScorePlugin = &TargetLoadPacking{}
+var _ debug.StateDumper = &TargetLoadPacking{}
+var _ debug.HandlerProvider = &TargetLoadPacking{}
 
 func New(ctx context.Context, obj runtime.Object, handle framework.Handle) (framework.Plugin, error) {
 	logger := klog.FromContext(ctx)
@@ -69,6 +75,9 @@ func New(ctx context.Context, obj runtime.Object, handle framework.Handle) (fram
 	if !ok {
 		return nil, fmt.Errorf("want args to be of type TargetLoadPackingArgs, got %T", obj)
 	}
+	if err := validation.ValidateTargetLoadPackingArgs(nil, args); err != nil {
+		return nil, err
+	}
 	collector, err := trimaran.NewCollector(logger, &args.TrimaranSpec)
 	if err != nil {
 		return nil, err
@@ -102,6 +111,16 @@ func (pl *TargetLoadPacking) Name() string {
 	return Name
 }
 
+// DumpState records the metrics last collected.
+func (pl *TargetLoadPacking) DumpState(state *debug.ProfileState) {
+	pl.collector.DumpState(Name, state)
+}
+
+// DebugHandlers serves the metrics last collected.
+func (pl *TargetLoadPacking) DebugHandlers() map[string]http.Handler {
+	return pl.collector.DebugHandlers()
+}
+
 func (pl *TargetLoadPacking) Score(ctx context.Context, cycleState *framework.CycleState, pod *v1.Pod, nodeName string) (int64, *framework.Status) {
 	logger := klog.FromContext(ctx)
 	score := framework.MinNodeScore
@@ -191,7 +210,8 @@ func (pl *TargetLoadPacking) ScoreExtensions() framework.ScoreExtensions {
 	return pl
 }
 
-func (pl *TargetLoadPacking) NormalizeScore(context.Context, *framework.CycleState, *v1.Pod, framework.NodeScoreList) *framework.Status {
+func (pl *TargetLoadPacking) NormalizeScore(_ context.Context, _ *framework.CycleState, _ *v1.Pod, scores framework.NodeScoreList) *framework.Status {
+	pluginmetrics.ObserveTrimaranScores(Name, scores)
 	return nil
 }
 
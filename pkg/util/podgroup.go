@@ -17,12 +17,18 @@ limitations under the License.
 package util
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"strings"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
 )
@@ -30,6 +36,10 @@ import (
 // DefaultWaitTime is 60s if ScheduleTimeoutSeconds is not specified.
 const DefaultWaitTime = 60 * time.Second
 
+// JobSetGVK is the JobSet kind PodGroups are automatically created for. JobSet
+// is handled as unstructured so that its CRD stays an optional dependency.
+var JobSetGVK = schema.GroupVersionKind{Group: "jobset.x-k8s.io", Version: "v1alpha2", Kind: "JobSet"}
+
 // CreateMergePatch return patch generated from original and new interfaces
 func CreateMergePatch(original, new interface{}) ([]byte, error) {
 	pvByte, err := json.Marshal(original)
@@ -74,3 +84,34 @@ func GetWaitTimeDuration(pg *v1alpha1.PodGroup, scheduleTimeout *time.Duration)
 	}
 	return DefaultWaitTime
 }
+
+// AutoPodGroupName returns the name of the PodGroup automatically created for
+// the workload of the given kind and name. The name is also the value of the
+// PodGroupLabel of the pods, so names longer than a label value are truncated
+// and suffixed with a hash of the kind and name of the workload, to stay unique.
+func AutoPodGroupName(kind, name string) string {
+	pgName := fmt.Sprintf("%s-%s", strings.ToLower(kind), name)
+	if len(pgName) <= validation.LabelValueMaxLength {
+		return pgName
+	}
+	h := fnv.New32a()
+	h.Write([]byte(kind + "/" + name))
+	suffix := fmt.Sprintf("%08x", h.Sum32())
+	// A label value must end with an alphanumeric character.
+	prefix := strings.TrimRight(pgName[:validation.LabelValueMaxLength-len(suffix)-1], "-.")
+	return prefix + "-" + suffix
+}
+
+// AutoPodGroupEnabled returns whether a PodGroup should be automatically created
+// for the workload: its AutoPodGroupKey annotation decides if set, otherwise the
+// AutoPodGroupKey label of its namespace does.
+func AutoPodGroupEnabled(ctx context.Context, c client.Reader, workload client.Object) (bool, error) {
+	if v, ok := workload.GetAnnotations()[v1alpha1.AutoPodGroupKey]; ok {
+		return v == "true", nil
+	}
+	ns := &v1.Namespace{}
+	if err := c.Get(ctx, client.ObjectKey{Name: workload.GetNamespace()}, ns); err != nil {
+		return false, err
+	}
+	return ns.Labels[v1alpha1.AutoPodGroupKey] == "true", nil
+}
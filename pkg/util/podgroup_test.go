@@ -17,8 +17,10 @@ limitations under the License.
 package util
 
 import (
+	"strings"
 	"testing"
 
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/kubernetes/pkg/apis/core"
 )
 
@@ -70,3 +72,37 @@ func TestCreateMergePatch(t *testing.T) {
 		}
 	}
 }
+
+func TestAutoPodGroupName(t *testing.T) {
+	longName := strings.Repeat("a", 52) + "-" + strings.Repeat("b", 10)
+	tests := []struct {
+		name string
+		kind string
+		// want is the expected name, if not truncated.
+		want string
+	}{
+		{name: "job", kind: "Job", want: "job-job"},
+		{name: longName, kind: "Job"},
+		{name: longName, kind: "ReplicaSet"},
+		{name: longName + "-5d4f8c7b9x", kind: "ReplicaSet"},
+		// The truncated prefix ends with a dash, which a label value cannot.
+		{name: strings.Repeat("c", 42) + "-" + strings.Repeat("d", 20), kind: "ReplicaSet"},
+	}
+	names := make(map[string]bool)
+	for _, tt := range tests {
+		got := AutoPodGroupName(tt.kind, tt.name)
+		if tt.want != "" && got != tt.want {
+			t.Errorf("AutoPodGroupName(%q, %q) = %q, want %q", tt.kind, tt.name, got, tt.want)
+		}
+		if errs := validation.IsValidLabelValue(got); len(errs) != 0 {
+			t.Errorf("AutoPodGroupName(%q, %q) = %q is not a valid label value: %v", tt.kind, tt.name, got, errs)
+		}
+		if errs := validation.IsDNS1123Subdomain(got); len(errs) != 0 {
+			t.Errorf("AutoPodGroupName(%q, %q) = %q is not a valid name: %v", tt.kind, tt.name, got, errs)
+		}
+		if names[got] {
+			t.Errorf("AutoPodGroupName(%q, %q) = %q is not unique", tt.kind, tt.name, got)
+		}
+		names[got] = true
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	v1 "k8s.io/api/core/v1"
+	quota "k8s.io/apiserver/pkg/quota/v1"
+)
+
+// The accounting of the pods in ElasticQuotas is shared by the CapacityScheduling plugin, which
+// enforces the quotas, and the ElasticQuota controller, which reports their usage, so that both agree.
+
+// IsPodTerminated returns true if the pod has terminated, releasing its resources.
+func IsPodTerminated(pod *v1.Pod) bool {
+	return pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed
+}
+
+// PodCountsInQuota returns true if the pod uses resources of the quota of its namespace: it is
+// assigned to a node and has not terminated. Pods which are bound but still pending, e.g. running
+// their init containers or pulling images, hold their resources on the node and count alike.
+func PodCountsInQuota(pod *v1.Pod) bool {
+	return len(pod.Spec.NodeName) != 0 && !IsPodTerminated(pod)
+}
+
+// PodPendingInQuota returns true if the pod waits to be scheduled, so that it will use resources
+// of the quota of its namespace once bound.
+func PodPendingInQuota(pod *v1.Pod) bool {
+	return len(pod.Spec.NodeName) == 0 && pod.DeletionTimestamp == nil && !IsPodTerminated(pod)
+}
+
+// PodQuotaRequest returns the resources of the quota used by the pod: the effective request of its
+// containers and init containers, plus its overhead.
+func PodQuotaRequest(pod *v1.Pod) v1.ResourceList {
+	return GetPodEffectiveRequest(pod)
+}
+
+// ComputeQuotaUsage returns the resources used by the pods counting in a quota, and the resources
+// requested by the pods pending in it. The returned lists are never nil.
+func ComputeQuotaUsage(pods []v1.Pod) (used, pending v1.ResourceList) {
+	used, pending = v1.ResourceList{}, v1.ResourceList{}
+	for i := range pods {
+		pod := &pods[i]
+		switch {
+		case PodCountsInQuota(pod):
+			used = quota.Add(used, PodQuotaRequest(pod))
+		case PodPendingInQuota(pod):
+			pending = quota.Add(pending, PodQuotaRequest(pod))
+		}
+	}
+	return used, pending
+}
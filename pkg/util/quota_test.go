@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	quota "k8s.io/apiserver/pkg/quota/v1"
+)
+
+func makeQuotaPod(nodeName string, phase v1.PodPhase, request v1.ResourceList) v1.Pod {
+	return v1.Pod{
+		Spec: v1.PodSpec{
+			NodeName:   nodeName,
+			Containers: []v1.Container{{Resources: v1.ResourceRequirements{Requests: request}}},
+		},
+		Status: v1.PodStatus{Phase: phase},
+	}
+}
+
+func TestComputeQuotaUsage(t *testing.T) {
+	deleting := makeQuotaPod("", v1.PodPending, makeResourceList(64, 64))
+	deleting.DeletionTimestamp = &metav1.Time{}
+	withOverhead := makeQuotaPod("node-a", v1.PodRunning, makeResourceList(1, 1))
+	withOverhead.Spec.InitContainers = []v1.Container{{Resources: v1.ResourceRequirements{Requests: makeResourceList(3, 1)}}}
+	withOverhead.Spec.Overhead = makeResourceList(1, 1)
+
+	tests := []struct {
+		name        string
+		pods        []v1.Pod
+		wantUsed    v1.ResourceList
+		wantPending v1.ResourceList
+	}{
+		{
+			name:        "no pods",
+			wantUsed:    v1.ResourceList{},
+			wantPending: v1.ResourceList{},
+		},
+		{
+			name: "bound pods count whatever their phase until they terminate",
+			pods: []v1.Pod{
+				makeQuotaPod("node-a", v1.PodRunning, makeResourceList(1, 1)),
+				makeQuotaPod("node-a", v1.PodPending, makeResourceList(2, 2)),
+				makeQuotaPod("node-a", v1.PodUnknown, makeResourceList(4, 4)),
+				makeQuotaPod("node-a", v1.PodSucceeded, makeResourceList(8, 8)),
+				makeQuotaPod("node-a", v1.PodFailed, makeResourceList(16, 16)),
+			},
+			wantUsed:    makeResourceList(7, 7),
+			wantPending: v1.ResourceList{},
+		},
+		{
+			name: "unbound pods are pending unless deleted",
+			pods: []v1.Pod{
+				makeQuotaPod("", v1.PodPending, makeResourceList(32, 32)),
+				deleting,
+			},
+			wantUsed:    v1.ResourceList{},
+			wantPending: makeResourceList(32, 32),
+		},
+		{
+			name:        "init containers and overhead",
+			pods:        []v1.Pod{withOverhead},
+			wantUsed:    makeResourceList(4, 2),
+			wantPending: v1.ResourceList{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			used, pending := ComputeQuotaUsage(tt.pods)
+			if !quota.Equals(used, tt.wantUsed) {
+				t.Errorf("ComputeQuotaUsage() used = %v, want %v", used, tt.wantUsed)
+			}
+			if !quota.Equals(pending, tt.wantPending) {
+				t.Errorf("ComputeQuotaUsage() pending = %v, want %v", pending, tt.wantPending)
+			}
+		})
+	}
+}
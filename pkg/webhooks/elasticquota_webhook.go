@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1beta1"
+)
+
+// +kubebuilder:webhook:path=/mutate-scheduling-x-k8s-io-v1beta1-elasticquota,mutating=true,failurePolicy=fail,sideEffects=None,groups=scheduling.x-k8s.io,resources=elasticquotas,verbs=create;update,versions=v1beta1,name=melasticquota.scheduling.x-k8s.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-scheduling-x-k8s-io-v1beta1-elasticquota,mutating=false,failurePolicy=fail,sideEffects=None,groups=scheduling.x-k8s.io,resources=elasticquotas,verbs=create;update,versions=v1beta1,name=velasticquota.scheduling.x-k8s.io,admissionReviewVersions=v1
+
+// ElasticQuotaWebhook defaults and validates ElasticQuota objects.
+type ElasticQuotaWebhook struct{}
+
+var _ admission.CustomDefaulter = &ElasticQuotaWebhook{}
+var _ admission.CustomValidator = &ElasticQuotaWebhook{}
+
+// SetupWebhookWithManager registers the ElasticQuota webhooks with the manager's webhook server.
+func (w *ElasticQuotaWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&v1beta1.ElasticQuota{}).
+		WithDefaulter(w).
+		WithValidator(w).
+		Complete()
+}
+
+// Default implements admission.CustomDefaulter. A resource bounded by Max but
+// missing from Min has no guarantee, so it is made explicit as a zero Min.
+func (w *ElasticQuotaWebhook) Default(ctx context.Context, obj runtime.Object) error {
+	eq, ok := obj.(*v1beta1.ElasticQuota)
+	if !ok {
+		return fmt.Errorf("want object to be of type ElasticQuota, got %T", obj)
+	}
+	for name, max := range eq.Spec.Max {
+		if _, ok := eq.Spec.Min[name]; ok {
+			continue
+		}
+		if eq.Spec.Min == nil {
+			eq.Spec.Min = v1.ResourceList{}
+		}
+		eq.Spec.Min[name] = *resource.NewQuantity(0, max.Format)
+	}
+	return nil
+}
+
+// ValidateCreate implements admission.CustomValidator.
+func (w *ElasticQuotaWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	eq, ok := obj.(*v1beta1.ElasticQuota)
+	if !ok {
+		return nil, fmt.Errorf("want object to be of type ElasticQuota, got %T", obj)
+	}
+	return nil, toInvalidError(eq.Name, "ElasticQuota", validateElasticQuota(eq))
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (w *ElasticQuotaWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	eq, ok := newObj.(*v1beta1.ElasticQuota)
+	if !ok {
+		return nil, fmt.Errorf("want object to be of type ElasticQuota, got %T", newObj)
+	}
+	return nil, toInvalidError(eq.Name, "ElasticQuota", validateElasticQuota(eq))
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (w *ElasticQuotaWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateElasticQuota checks that all quantities are non-negative and that
+// Min does not exceed Max for any resource bounded by both. A resource
+// missing from Max is unbounded, so any Min is allowed for it.
+func validateElasticQuota(eq *v1beta1.ElasticQuota) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	allErrs = append(allErrs, validateResourceList(specPath.Child("min"), eq.Spec.Min)...)
+	allErrs = append(allErrs, validateResourceList(specPath.Child("max"), eq.Spec.Max)...)
+	for _, name := range sortedResourceNames(eq.Spec.Min) {
+		min := eq.Spec.Min[name]
+		max, ok := eq.Spec.Max[name]
+		if ok && min.Cmp(max) > 0 {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("min").Key(string(name)), min.String(),
+				fmt.Sprintf("must be less than or equal to max %s", max.String())))
+		}
+	}
+	return allErrs
+}
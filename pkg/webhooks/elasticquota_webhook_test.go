@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1beta1"
+)
+
+func makeEQ(min, max v1.ResourceList) *v1beta1.ElasticQuota {
+	return &v1beta1.ElasticQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "eq", Namespace: "ns"},
+		Spec:       v1beta1.ElasticQuotaSpec{Min: min, Max: max},
+	}
+}
+
+func TestElasticQuotaDefault(t *testing.T) {
+	tests := []struct {
+		name     string
+		eq       *v1beta1.ElasticQuota
+		expected v1.ResourceList
+	}{
+		{
+			name:     "no max",
+			eq:       makeEQ(v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")}, nil),
+			expected: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")},
+		},
+		{
+			name: "missing min filled with zero",
+			eq: makeEQ(nil, v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("4"),
+				v1.ResourceMemory: resource.MustParse("4Gi"),
+			}),
+			expected: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("0"),
+				v1.ResourceMemory: resource.MustParse("0"),
+			},
+		},
+		{
+			name: "explicit min kept",
+			eq: makeEQ(
+				v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")},
+				v1.ResourceList{v1.ResourceCPU: resource.MustParse("4"), "nvidia.com/gpu": resource.MustParse("2")},
+			),
+			expected: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2"), "nvidia.com/gpu": resource.MustParse("0")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := (&ElasticQuotaWebhook{}).Default(context.TODO(), tt.eq); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !equality.Semantic.DeepEqual(tt.eq.Spec.Min, tt.expected) {
+				t.Errorf("expected min %v, got %v", tt.expected, tt.eq.Spec.Min)
+			}
+		})
+	}
+}
+
+func TestElasticQuotaValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		eq          *v1beta1.ElasticQuota
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			eq: makeEQ(
+				v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")},
+				v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")},
+			),
+		},
+		{
+			name: "min equals max",
+			eq: makeEQ(
+				v1.ResourceList{v1.ResourceMemory: resource.MustParse("1Gi")},
+				v1.ResourceList{v1.ResourceMemory: resource.MustParse("1024Mi")},
+			),
+		},
+		{
+			name: "min for unbounded resource",
+			eq: makeEQ(
+				v1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")},
+				v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")},
+			),
+		},
+		{
+			name: "min greater than max",
+			eq: makeEQ(
+				v1.ResourceList{v1.ResourceCPU: resource.MustParse("5")},
+				v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")},
+			),
+			expectedErr: "spec.min[cpu]: Invalid value: \"5\": must be less than or equal to max 4",
+		},
+		{
+			name: "negative max",
+			eq: makeEQ(nil,
+				v1.ResourceList{v1.ResourceMemory: resource.MustParse("-1Gi")},
+			),
+			expectedErr: "spec.max[memory]: Invalid value: \"-1Gi\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &ElasticQuotaWebhook{}
+			_, err := w.ValidateCreate(context.TODO(), tt.eq)
+			checkErr(t, err, tt.expectedErr)
+			_, err = w.ValidateUpdate(context.TODO(), makeEQ(nil, nil), tt.eq)
+			checkErr(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/util"
+)
+
+// +kubebuilder:webhook:path=/mutate--v1-pod,mutating=true,failurePolicy=ignore,sideEffects=None,groups="",resources=pods,verbs=create,versions=v1,name=mpod.scheduling.x-k8s.io,admissionReviewVersions=v1
+
+// PodWebhook labels the pods of workloads opted in to automatic PodGroup
+// creation with the name of their workload's PodGroup.
+type PodWebhook struct {
+	Client client.Reader
+	// APIReader reads the workloads missing from the cache of Client, which
+	// lags behind the API server: the pods of a workload are created right
+	// after it. No workload is read from the API server when nil.
+	APIReader client.Reader
+}
+
+var _ admission.CustomDefaulter = &PodWebhook{}
+
+// SetupWebhookWithManager registers the Pod webhook with the manager's webhook server.
+func (w *PodWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&v1.Pod{}).
+		WithDefaulter(w).
+		Complete()
+}
+
+// Default implements admission.CustomDefaulter. Pods already labeled with a
+// PodGroup are left untouched.
+func (w *PodWebhook) Default(ctx context.Context, obj runtime.Object) error {
+	pod, ok := obj.(*v1.Pod)
+	if !ok {
+		return fmt.Errorf("want object to be of type Pod, got %T", obj)
+	}
+	if util.GetPodGroupLabel(pod) != "" {
+		return nil
+	}
+	namespace := pod.Namespace
+	if namespace == "" {
+		if req, err := admission.RequestFromContext(ctx); err == nil {
+			namespace = req.Namespace
+		}
+	}
+	workload, kind, err := w.podWorkload(ctx, namespace, metav1.GetControllerOf(pod))
+	if err != nil || workload == nil {
+		return err
+	}
+	enabled, err := util.AutoPodGroupEnabled(ctx, w.Client, workload)
+	if err != nil || !enabled {
+		return err
+	}
+	if pod.Labels == nil {
+		pod.Labels = map[string]string{}
+	}
+	pod.Labels[v1alpha1.PodGroupLabel] = util.AutoPodGroupName(kind, workload.GetName())
+	return nil
+}
+
+// podWorkload returns the workload a PodGroup is created for from the pod's
+// controller, along with its kind. Pods of a JobSet are controlled by one of
+// its Jobs, so the Job's own controller is followed. It returns a nil workload
+// for pods not controlled by a supported kind.
+func (w *PodWebhook) podWorkload(ctx context.Context, namespace string, ref *metav1.OwnerReference) (client.Object, string, error) {
+	if ref == nil {
+		return nil, "", nil
+	}
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return nil, "", nil
+	}
+	var workload client.Object
+	switch gv.WithKind(ref.Kind).GroupKind() {
+	case batchv1.SchemeGroupVersion.WithKind("Job").GroupKind():
+		job := &batchv1.Job{}
+		if err := w.getWorkload(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, job); err != nil {
+			return nil, "", client.IgnoreNotFound(err)
+		}
+		if owner := metav1.GetControllerOf(job); owner != nil && owner.Kind == util.JobSetGVK.Kind &&
+			owner.APIVersion == util.JobSetGVK.GroupVersion().String() {
+			return w.podWorkload(ctx, namespace, owner)
+		}
+		return job, ref.Kind, nil
+	case appsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind():
+		workload = &appsv1.StatefulSet{}
+	case appsv1.SchemeGroupVersion.WithKind("ReplicaSet").GroupKind():
+		workload = &appsv1.ReplicaSet{}
+	case util.JobSetGVK.GroupKind():
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(util.JobSetGVK)
+		workload = u
+	default:
+		return nil, "", nil
+	}
+	if err := w.getWorkload(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, workload); err != nil {
+		return nil, "", client.IgnoreNotFound(err)
+	}
+	return workload, ref.Kind, nil
+}
+
+// getWorkload gets the workload from the cache, falling back to the API server
+// when the cache has not seen it yet.
+func (w *PodWebhook) getWorkload(ctx context.Context, key client.ObjectKey, workload client.Object) error {
+	err := w.Client.Get(ctx, key, workload)
+	if apierrors.IsNotFound(err) && w.APIReader != nil {
+		return w.APIReader.Get(ctx, key, workload)
+	}
+	return err
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/util"
+)
+
+func controllerRef(apiVersion, kind, name string) []metav1.OwnerReference {
+	return []metav1.OwnerReference{{APIVersion: apiVersion, Kind: kind, Name: name, UID: "uid", Controller: ptr.To(true)}}
+}
+
+func makeOwnedPod(labels map[string]string, owners []metav1.OwnerReference) *v1.Pod {
+	return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "ns", Labels: labels, OwnerReferences: owners}}
+}
+
+func TestPodDefault(t *testing.T) {
+	optedIn := map[string]string{v1alpha1.AutoPodGroupKey: "true"}
+	// longName is as long as a Job name can be.
+	longName := strings.Repeat("j", 63)
+	jobSet := &unstructured.Unstructured{}
+	jobSet.SetGroupVersionKind(util.JobSetGVK)
+	jobSet.SetNamespace("ns")
+	jobSet.SetName("js")
+
+	tests := []struct {
+		name      string
+		nsLabels  map[string]string
+		pod       *v1.Pod
+		wantLabel string
+	}{
+		{
+			name:      "job pod in opted-in namespace",
+			nsLabels:  optedIn,
+			pod:       makeOwnedPod(nil, controllerRef("batch/v1", "Job", "job")),
+			wantLabel: "job-job",
+		},
+		{
+			name: "job pod in namespace not opted in",
+			pod:  makeOwnedPod(nil, controllerRef("batch/v1", "Job", "job")),
+		},
+		{
+			name:      "pod of job with the longest name",
+			nsLabels:  optedIn,
+			pod:       makeOwnedPod(nil, controllerRef("batch/v1", "Job", longName)),
+			wantLabel: util.AutoPodGroupName("Job", longName),
+		},
+		{
+			name:      "pod of annotated statefulset",
+			pod:       makeOwnedPod(map[string]string{"app": "db"}, controllerRef("apps/v1", "StatefulSet", "sts")),
+			wantLabel: "statefulset-sts",
+		},
+		{
+			name:      "jobset pod labeled after the jobset",
+			nsLabels:  optedIn,
+			pod:       makeOwnedPod(nil, controllerRef("batch/v1", "Job", "js-worker-0")),
+			wantLabel: "jobset-js",
+		},
+		{
+			name:      "explicit PodGroup label kept",
+			nsLabels:  optedIn,
+			pod:       makeOwnedPod(map[string]string{v1alpha1.PodGroupLabel: "mine"}, controllerRef("batch/v1", "Job", "job")),
+			wantLabel: "mine",
+		},
+		{
+			name:     "pod without controller",
+			nsLabels: optedIn,
+			pod:      makeOwnedPod(nil, nil),
+		},
+		{
+			name:     "pod of missing workload",
+			nsLabels: optedIn,
+			pod:      makeOwnedPod(nil, controllerRef("apps/v1", "ReplicaSet", "gone")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(s)
+			s.AddKnownTypeWithName(util.JobSetGVK, &unstructured.Unstructured{})
+			cl := fake.NewClientBuilder().WithScheme(s).WithObjects(
+				&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns", Labels: tt.nsLabels}},
+				&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "ns"}},
+				&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: longName, Namespace: "ns"}},
+				&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "js-worker-0", Namespace: "ns",
+					OwnerReferences: controllerRef(util.JobSetGVK.GroupVersion().String(), "JobSet", "js")}},
+				&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "sts", Namespace: "ns",
+					Annotations: map[string]string{v1alpha1.AutoPodGroupKey: "true"}}},
+				jobSet.DeepCopy(),
+			).Build()
+
+			w := &PodWebhook{Client: cl}
+			if err := w.Default(context.TODO(), tt.pod); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := tt.pod.Labels[v1alpha1.PodGroupLabel]; got != tt.wantLabel {
+				t.Errorf("expected PodGroup label %q, got %q", tt.wantLabel, got)
+			}
+			if errs := validation.ValidateLabels(tt.pod.Labels, field.NewPath("labels")); len(errs) != 0 {
+				t.Errorf("invalid pod labels: %v", errs)
+			}
+		})
+	}
+}
+
+func TestPodDefaultCacheMiss(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(s)
+	ns := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns", Labels: map[string]string{v1alpha1.AutoPodGroupKey: "true"}}}
+	// The cache has not seen the Job created right before its pod yet.
+	cache := fake.NewClientBuilder().WithScheme(s).WithObjects(ns).Build()
+	apiReader := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		ns.DeepCopy(),
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "ns"}},
+	).Build()
+
+	tests := []struct {
+		name      string
+		apiReader client.Reader
+		wantLabel string
+	}{
+		{
+			name:      "workload read from the API server",
+			apiReader: apiReader,
+			wantLabel: "job-job",
+		},
+		{
+			name: "workload not found without API reader",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := makeOwnedPod(nil, controllerRef("batch/v1", "Job", "job"))
+			w := &PodWebhook{Client: cache, APIReader: tt.apiReader}
+			if err := w.Default(context.TODO(), pod); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := pod.Labels[v1alpha1.PodGroupLabel]; got != tt.wantLabel {
+				t.Errorf("expected PodGroup label %q, got %q", tt.wantLabel, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1beta1"
+)
+
+// +kubebuilder:webhook:path=/mutate-scheduling-x-k8s-io-v1beta1-podgroup,mutating=true,failurePolicy=fail,sideEffects=None,groups=scheduling.x-k8s.io,resources=podgroups,verbs=create;update,versions=v1beta1,name=mpodgroup.scheduling.x-k8s.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-scheduling-x-k8s-io-v1beta1-podgroup,mutating=false,failurePolicy=fail,sideEffects=None,groups=scheduling.x-k8s.io,resources=podgroups,verbs=create;update,versions=v1beta1,name=vpodgroup.scheduling.x-k8s.io,admissionReviewVersions=v1
+
+// PodGroupWebhook defaults and validates PodGroup objects.
+type PodGroupWebhook struct {
+	// DefaultScheduleTimeoutSeconds is set on PodGroups that do not specify
+	// ScheduleTimeoutSeconds. Nil leaves the field unset, in which case the
+	// Coscheduling plugin falls back to its PermitWaitingTimeSeconds.
+	DefaultScheduleTimeoutSeconds *int32
+}
+
+var _ admission.CustomDefaulter = &PodGroupWebhook{}
+var _ admission.CustomValidator = &PodGroupWebhook{}
+
+// SetupWebhookWithManager registers the PodGroup webhooks with the manager's webhook server.
+func (w *PodGroupWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&v1beta1.PodGroup{}).
+		WithDefaulter(w).
+		WithValidator(w).
+		Complete()
+}
+
+// Default implements admission.CustomDefaulter.
+func (w *PodGroupWebhook) Default(ctx context.Context, obj runtime.Object) error {
+	pg, ok := obj.(*v1beta1.PodGroup)
+	if !ok {
+		return fmt.Errorf("want object to be of type PodGroup, got %T", obj)
+	}
+	if pg.Spec.ScheduleTimeoutSeconds == nil && w.DefaultScheduleTimeoutSeconds != nil {
+		timeout := *w.DefaultScheduleTimeoutSeconds
+		pg.Spec.ScheduleTimeoutSeconds = &timeout
+	}
+	return nil
+}
+
+// ValidateCreate implements admission.CustomValidator.
+func (w *PodGroupWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	pg, ok := obj.(*v1beta1.PodGroup)
+	if !ok {
+		return nil, fmt.Errorf("want object to be of type PodGroup, got %T", obj)
+	}
+	return nil, toInvalidError(pg.Name, "PodGroup", validatePodGroup(pg))
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (w *PodGroupWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldPG, ok := oldObj.(*v1beta1.PodGroup)
+	if !ok {
+		return nil, fmt.Errorf("want object to be of type PodGroup, got %T", oldObj)
+	}
+	pg, ok := newObj.(*v1beta1.PodGroup)
+	if !ok {
+		return nil, fmt.Errorf("want object to be of type PodGroup, got %T", newObj)
+	}
+	allErrs := validatePodGroup(pg)
+	allErrs = append(allErrs, validatePodGroupUpdate(oldPG, pg)...)
+	return nil, toInvalidError(pg.Name, "PodGroup", allErrs)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (w *PodGroupWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validatePodGroup(pg *v1beta1.PodGroup) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	if pg.Spec.MinMember < 1 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("minMember"), pg.Spec.MinMember, "must be greater than or equal to 1"))
+	}
+	if pg.Spec.ScheduleTimeoutSeconds != nil && *pg.Spec.ScheduleTimeoutSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("scheduleTimeoutSeconds"), *pg.Spec.ScheduleTimeoutSeconds, "must be greater than or equal to 0"))
+	}
+	allErrs = append(allErrs, validateResourceList(specPath.Child("minResources"), pg.Spec.MinResources)...)
+	return allErrs
+}
+
+// validatePodGroupUpdate rejects MinMember changes while the gang is only
+// partially placed: the scheduler has already committed some members against
+// the old MinMember and changing it would leave them waiting or over-admitted.
+func validatePodGroupUpdate(oldPG, pg *v1beta1.PodGroup) field.ErrorList {
+	var allErrs field.ErrorList
+	if oldPG.Spec.MinMember != pg.Spec.MinMember && partiallyBound(oldPG) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "minMember"),
+			fmt.Sprintf("may not be changed while the pod group is partially bound (phase %q)", oldPG.Status.Phase)))
+	}
+	return allErrs
+}
+
+// partiallyBound returns true if some, but not all, of the MinMember pods of
+// the pod group have been placed.
+func partiallyBound(pg *v1beta1.PodGroup) bool {
+	switch pg.Status.Phase {
+	case v1beta1.PodGroupScheduling, v1beta1.PodGroupUnknown:
+		return true
+	case v1beta1.PodGroupRunning, v1beta1.PodGroupFinished, v1beta1.PodGroupFailed:
+		return false
+	}
+	placed := pg.Status.Running + pg.Status.Succeeded
+	return placed > 0 && placed < pg.Spec.MinMember
+}
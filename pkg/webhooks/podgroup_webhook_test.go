@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1beta1"
+)
+
+func makePG(minMember int32, timeout *int32, phase v1beta1.PodGroupPhase, running int32) *v1beta1.PodGroup {
+	return &v1beta1.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "pg", Namespace: "ns"},
+		Spec: v1beta1.PodGroupSpec{
+			MinMember:              minMember,
+			ScheduleTimeoutSeconds: timeout,
+		},
+		Status: v1beta1.PodGroupStatus{
+			Phase:   phase,
+			Running: running,
+		},
+	}
+}
+
+func TestPodGroupDefault(t *testing.T) {
+	tests := []struct {
+		name           string
+		defaultTimeout *int32
+		pg             *v1beta1.PodGroup
+		expected       *int32
+	}{
+		{
+			name:     "no default configured",
+			pg:       makePG(2, nil, "", 0),
+			expected: nil,
+		},
+		{
+			name:           "timeout defaulted",
+			defaultTimeout: ptr.To[int32](30),
+			pg:             makePG(2, nil, "", 0),
+			expected:       ptr.To[int32](30),
+		},
+		{
+			name:           "explicit timeout kept",
+			defaultTimeout: ptr.To[int32](30),
+			pg:             makePG(2, ptr.To[int32](0), "", 0),
+			expected:       ptr.To[int32](0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &PodGroupWebhook{DefaultScheduleTimeoutSeconds: tt.defaultTimeout}
+			if err := w.Default(context.TODO(), tt.pg); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := tt.pg.Spec.ScheduleTimeoutSeconds
+			if (got == nil) != (tt.expected == nil) || (got != nil && *got != *tt.expected) {
+				t.Errorf("expected timeout %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestPodGroupValidateCreate(t *testing.T) {
+	tests := []struct {
+		name        string
+		pg          *v1beta1.PodGroup
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			pg:   makePG(3, ptr.To[int32](10), "", 0),
+		},
+		{
+			name:        "zero minMember",
+			pg:          makePG(0, nil, "", 0),
+			expectedErr: "spec.minMember: Invalid value: 0",
+		},
+		{
+			name:        "negative scheduleTimeoutSeconds",
+			pg:          makePG(1, ptr.To[int32](-1), "", 0),
+			expectedErr: "spec.scheduleTimeoutSeconds: Invalid value: -1",
+		},
+		{
+			name: "negative minResources",
+			pg: func() *v1beta1.PodGroup {
+				pg := makePG(1, nil, "", 0)
+				pg.Spec.MinResources = v1.ResourceList{v1.ResourceCPU: resource.MustParse("-1")}
+				return pg
+			}(),
+			expectedErr: "spec.minResources[cpu]: Invalid value: \"-1\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := (&PodGroupWebhook{}).ValidateCreate(context.TODO(), tt.pg)
+			checkErr(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestPodGroupValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name        string
+		oldPG       *v1beta1.PodGroup
+		pg          *v1beta1.PodGroup
+		expectedErr string
+	}{
+		{
+			name:  "minMember changed while pending",
+			oldPG: makePG(3, nil, v1beta1.PodGroupPending, 0),
+			pg:    makePG(4, nil, v1beta1.PodGroupPending, 0),
+		},
+		{
+			name:  "minMember changed while running",
+			oldPG: makePG(3, nil, v1beta1.PodGroupRunning, 3),
+			pg:    makePG(2, nil, v1beta1.PodGroupRunning, 3),
+		},
+		{
+			name:        "minMember changed while scheduling",
+			oldPG:       makePG(3, nil, v1beta1.PodGroupScheduling, 1),
+			pg:          makePG(4, nil, v1beta1.PodGroupScheduling, 1),
+			expectedErr: "spec.minMember: Forbidden",
+		},
+		{
+			name:        "minMember changed while some members run",
+			oldPG:       makePG(3, nil, v1beta1.PodGroupPending, 1),
+			pg:          makePG(2, nil, v1beta1.PodGroupPending, 1),
+			expectedErr: "spec.minMember: Forbidden",
+		},
+		{
+			name:  "timeout changed while scheduling",
+			oldPG: makePG(3, nil, v1beta1.PodGroupScheduling, 1),
+			pg:    makePG(3, ptr.To[int32](5), v1beta1.PodGroupScheduling, 1),
+		},
+		{
+			name:        "invalid update",
+			oldPG:       makePG(3, nil, v1beta1.PodGroupPending, 0),
+			pg:          makePG(0, nil, v1beta1.PodGroupPending, 0),
+			expectedErr: "spec.minMember: Invalid value: 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := (&PodGroupWebhook{}).ValidateUpdate(context.TODO(), tt.oldPG, tt.pg)
+			checkErr(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func checkErr(t *testing.T, err error, expectedErr string) {
+	t.Helper()
+	if expectedErr == "" {
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		return
+	}
+	if err == nil || !strings.Contains(err.Error(), expectedErr) {
+		t.Errorf("expected error containing %q, got %v", expectedErr, err)
+	}
+}
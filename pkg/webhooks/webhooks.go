@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhooks contains the defaulting and validating admission webhooks
+// for the scheduling.x-k8s.io CRDs, served by the scheduler-plugins controller.
+package webhooks
+
+import (
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling"
+)
+
+// validateResourceList checks that no quantity in the list is negative.
+func validateResourceList(path *field.Path, list v1.ResourceList) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, name := range sortedResourceNames(list) {
+		q := list[name]
+		if q.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(path.Key(string(name)), q.String(), "must be greater than or equal to 0"))
+		}
+	}
+	return allErrs
+}
+
+// sortedResourceNames returns the names in the list in a stable order, so
+// that error messages do not depend on map iteration.
+func sortedResourceNames(list v1.ResourceList) []v1.ResourceName {
+	names := make([]v1.ResourceName, 0, len(list))
+	for name := range list {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+// toInvalidError turns a non-empty error list into an Invalid API status error.
+func toInvalidError(name, kind string, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(schema.GroupKind{Group: scheduling.GroupName, Kind: kind}, name, allErrs)
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1beta1"
+)
+
+// TestWebhooks runs the admission and conversion webhooks behind a real API
+// server. It needs the envtest binaries, which `make unit-test` installs and
+// exposes through KUBEBUILDER_ASSETS.
+func TestWebhooks(t *testing.T) {
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS is not set, skipping envtest based webhook test")
+	}
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	utilruntime.Must(v1beta1.AddToScheme(scheme))
+
+	testEnv := &envtest.Environment{
+		CRDDirectoryPaths: []string{
+			filepath.Join("..", "..", "manifests", "crds"),
+		},
+		// With the scheme, envtest points the conversion of the convertible CRDs
+		// to the local webhook server.
+		CRDInstallOptions: envtest.CRDInstallOptions{
+			Scheme: scheme,
+		},
+		WebhookInstallOptions: envtest.WebhookInstallOptions{
+			Paths: []string{filepath.Join("..", "..", "manifests", "webhook")},
+		},
+	}
+	cfg, err := testEnv.Start()
+	if err != nil {
+		t.Fatalf("failed to start envtest: %v", err)
+	}
+	defer testEnv.Stop()
+
+	opts := testEnv.WebhookInstallOptions
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		Scheme:  scheme,
+		Metrics: metricsserver.Options{BindAddress: "0"},
+		WebhookServer: webhook.NewServer(webhook.Options{
+			Host:    opts.LocalServingHost,
+			Port:    opts.LocalServingPort,
+			CertDir: opts.LocalServingCertDir,
+		}),
+	})
+	if err != nil {
+		t.Fatalf("failed to create manager: %v", err)
+	}
+	if err := (&PodGroupWebhook{DefaultScheduleTimeoutSeconds: ptr.To[int32](60)}).SetupWebhookWithManager(mgr); err != nil {
+		t.Fatal(err)
+	}
+	if err := (&ElasticQuotaWebhook{}).SetupWebhookWithManager(mgr); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		if err := mgr.Start(ctx); err != nil {
+			t.Errorf("failed to start manager: %v", err)
+		}
+	}()
+
+	// Wait for the webhook server to serve before sending requests.
+	addr := net.JoinHostPort(opts.LocalServingHost, fmt.Sprint(opts.LocalServingPort))
+	if err := wait.PollUntilContextTimeout(ctx, 100*time.Millisecond, 10*time.Second, true, func(context.Context) (bool, error) {
+		conn, err := tls.DialWithDialer(&net.Dialer{Timeout: time.Second}, "tcp", addr, &tls.Config{InsecureSkipVerify: true}) // #nosec G402
+		if err != nil {
+			return false, nil
+		}
+		return true, conn.Close()
+	}); err != nil {
+		t.Fatalf("webhook server not ready: %v", err)
+	}
+
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The admission webhooks are registered for v1beta1: the v1alpha1 requests
+	// reach them once converted, as the webhook configurations match equivalent versions.
+	t.Run("PodGroup", func(t *testing.T) {
+		if err := c.Create(ctx, makeEnvtestPG("zero-min", 0, nil)); !apierrors.IsInvalid(err) {
+			t.Errorf("expected invalid error for MinMember 0, got %v", err)
+		}
+		if err := c.Create(ctx, makeEnvtestPG("negative-timeout", 1, ptr.To[int32](-1))); !apierrors.IsInvalid(err) {
+			t.Errorf("expected invalid error for negative ScheduleTimeoutSeconds, got %v", err)
+		}
+
+		pg := makeEnvtestPG("pg", 3, nil)
+		if err := c.Create(ctx, pg); err != nil {
+			t.Fatalf("failed to create PodGroup: %v", err)
+		}
+		if pg.Spec.ScheduleTimeoutSeconds == nil || *pg.Spec.ScheduleTimeoutSeconds != 60 {
+			t.Errorf("expected ScheduleTimeoutSeconds to be defaulted to 60, got %v", pg.Spec.ScheduleTimeoutSeconds)
+		}
+
+		pg.Status.Phase = v1alpha1.PodGroupScheduling
+		pg.Status.Running = 1
+		if err := c.Status().Update(ctx, pg); err != nil {
+			t.Fatalf("failed to update PodGroup status: %v", err)
+		}
+		pg.Spec.MinMember = 4
+		if err := c.Update(ctx, pg); !apierrors.IsInvalid(err) {
+			t.Errorf("expected invalid error when changing MinMember of a partially bound gang, got %v", err)
+		}
+	})
+
+	t.Run("ElasticQuota", func(t *testing.T) {
+		bad := makeEnvtestEQ(
+			v1.ResourceList{v1.ResourceCPU: resource.MustParse("5")},
+			v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")},
+		)
+		if err := c.Create(ctx, bad); !apierrors.IsInvalid(err) {
+			t.Errorf("expected invalid error for Min > Max, got %v", err)
+		}
+
+		eq := makeEnvtestEQ(nil, v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")})
+		if err := c.Create(ctx, eq); err != nil {
+			t.Fatalf("failed to create ElasticQuota: %v", err)
+		}
+		if min, ok := eq.Spec.Min[v1.ResourceCPU]; !ok || !min.IsZero() {
+			t.Errorf("expected Min cpu to be defaulted to 0, got %v", eq.Spec.Min)
+		}
+	})
+
+	t.Run("Conversion", func(t *testing.T) {
+		pg := makeEnvtestPG("converted", 2, ptr.To[int32](30))
+		pg.Spec.MinResources = v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")}
+		if err := c.Create(ctx, pg); err != nil {
+			t.Fatalf("failed to create PodGroup: %v", err)
+		}
+		beta := &v1beta1.PodGroup{}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(pg), beta); err != nil {
+			t.Fatalf("failed to get v1beta1 PodGroup: %v", err)
+		}
+		alpha := &v1alpha1.PodGroup{}
+		if err := alpha.ConvertFrom(beta); err != nil {
+			t.Fatal(err)
+		}
+		if !equality.Semantic.DeepEqual(pg.Spec, alpha.Spec) {
+			t.Errorf("expected spec %v, got %v", pg.Spec, alpha.Spec)
+		}
+	})
+}
+
+func makeEnvtestPG(name string, minMember int32, timeout *int32) *v1alpha1.PodGroup {
+	return &v1alpha1.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: v1alpha1.PodGroupSpec{
+			MinMember:              minMember,
+			ScheduleTimeoutSeconds: timeout,
+		},
+	}
+}
+
+func makeEnvtestEQ(min, max v1.ResourceList) *v1alpha1.ElasticQuota {
+	return &v1alpha1.ElasticQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "eq", Namespace: "default"},
+		Spec:       v1alpha1.ElasticQuotaSpec{Min: min, Max: max},
+	}
+}
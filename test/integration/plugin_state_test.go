@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	topologyv1alpha2 "github.com/k8stopologyawareschedwg/noderesourcetopology-api/pkg/apis/topology/v1alpha2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/debug"
+)
+
+func TestPluginStateFixtures(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	utilruntime.Must(topologyv1alpha2.AddToScheme(scheme))
+	client, err := ctrlclient.New(globalKubeConfig, ctrlclient.Options{Scheme: scheme})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	nrt := topologyv1alpha2.NodeResourceTopology{
+		ObjectMeta:       metav1.ObjectMeta{Name: "fixture-node", ResourceVersion: "42", UID: "nrt-uid"},
+		TopologyPolicies: []string{string(topologyv1alpha2.SingleNUMANodePodLevel)},
+		Zones: topologyv1alpha2.ZoneList{{
+			Name: "node-0",
+			Type: "Node",
+			Resources: topologyv1alpha2.ResourceInfoList{{
+				Name:        string(v1.ResourceCPU),
+				Capacity:    resource.MustParse("4"),
+				Allocatable: resource.MustParse("4"),
+				Available:   resource.MustParse("2"),
+			}},
+		}},
+	}
+	pg := v1alpha1.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "fixture-pg", Name: "pg", ResourceVersion: "42", UID: "pg-uid"},
+		Spec:       v1alpha1.PodGroupSpec{MinMember: 3},
+	}
+	snapshot := &debug.Snapshot{
+		Version: debug.SnapshotVersion,
+		Time:    metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Profiles: map[string]*debug.ProfileState{
+			"default-scheduler": {
+				Coscheduling: &debug.CoschedulingState{
+					AssignedPods: map[string][]string{"fixture-pg/pg": {"p1"}},
+					PodGroups:    []v1alpha1.PodGroup{pg},
+				},
+				CapacityScheduling: &debug.CapacitySchedulingState{
+					ElasticQuotas: map[string]*debug.ElasticQuotaState{
+						"fixture-eq": {
+							Name: "quota",
+							Min:  &framework.Resource{MilliCPU: 1000},
+							Max:  &framework.Resource{MilliCPU: 2000, Memory: math.MaxInt64, EphemeralStorage: math.MaxInt64},
+							Used: &framework.Resource{},
+						},
+					},
+				},
+				NodeResourceTopology: &debug.NodeResourceTopologyState{
+					NodeResourceTopologies: []topologyv1alpha2.NodeResourceTopology{nrt},
+				},
+			},
+		},
+	}
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := debug.WriteSnapshot(f, snapshot); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := createPluginStateFixtures(ctx, client, path, "other-scheduler"); err == nil {
+		t.Error("Want an error reading a profile missing from the snapshot")
+	}
+	state, err := createPluginStateFixtures(ctx, client, path, "default-scheduler")
+	if err != nil {
+		t.Fatalf("Failed to create the fixtures: %v", err)
+	}
+	defer func() {
+		for _, obj := range []ctrlclient.Object{
+			&topologyv1alpha2.NodeResourceTopology{ObjectMeta: metav1.ObjectMeta{Name: nrt.Name}},
+			&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "fixture-pg"}},
+			&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "fixture-eq"}},
+		} {
+			client.Delete(ctx, obj)
+		}
+	}()
+	if diff := cmp.Diff(snapshot.Profiles["default-scheduler"], state); diff != "" {
+		t.Errorf("Unexpected profile state (-want,+got):\n%s", diff)
+	}
+
+	var gotNRT topologyv1alpha2.NodeResourceTopology
+	if err := client.Get(ctx, ctrlclient.ObjectKey{Name: nrt.Name}, &gotNRT); err != nil {
+		t.Fatalf("Failed to get the NodeResourceTopology: %v", err)
+	}
+	if diff := cmp.Diff(nrt.Zones, gotNRT.Zones); diff != "" {
+		t.Errorf("Unexpected NodeResourceTopology zones (-want,+got):\n%s", diff)
+	}
+
+	var gotPG v1alpha1.PodGroup
+	if err := client.Get(ctx, ctrlclient.ObjectKeyFromObject(&pg), &gotPG); err != nil {
+		t.Fatalf("Failed to get the PodGroup: %v", err)
+	}
+	if diff := cmp.Diff(pg.Spec, gotPG.Spec); diff != "" {
+		t.Errorf("Unexpected PodGroup spec (-want,+got):\n%s", diff)
+	}
+
+	var gotEQ v1alpha1.ElasticQuota
+	if err := client.Get(ctx, ctrlclient.ObjectKey{Namespace: "fixture-eq", Name: "quota"}, &gotEQ); err != nil {
+		t.Fatalf("Failed to get the ElasticQuota: %v", err)
+	}
+	wantEQ := state.CapacityScheduling.Quotas()[0]
+	quantityEqual := cmp.Comparer(func(a, b resource.Quantity) bool { return a.Cmp(b) == 0 })
+	if diff := cmp.Diff(wantEQ.Spec, gotEQ.Spec, quantityEqual); diff != "" {
+		t.Errorf("Unexpected ElasticQuota spec (-want,+got):\n%s", diff)
+	}
+}
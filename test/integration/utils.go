@@ -45,6 +45,7 @@ import (
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
+	"sigs.k8s.io/scheduler-plugins/pkg/debug"
 )
 
 var lowPriority, midPriority, highPriority = int32(0), int32(100), int32(1000)
@@ -122,6 +123,11 @@ func (p *podWrapper) InitContainerRequest(request v1.ResourceList) *podWrapper {
 	return p
 }
 
+func (p *podWrapper) Overhead(overhead v1.ResourceList) *podWrapper {
+	p.Pod.Spec.Overhead = overhead
+	return p
+}
+
 func (p *podWrapper) Node(name string) *podWrapper {
 	p.Pod.Spec.NodeName = name
 	return p
@@ -260,6 +266,58 @@ func createNamespace(t *testing.T, testCtx *testContext, ns string) {
 	}
 }
 
+// createPluginStateFixtures reads a snapshot of the state of the plugins, as served by the scheduler,
+// and creates the objects the plugins of the given profile rebuild their state from: the
+// NodeResourceTopologies, the PodGroups and the ElasticQuotas, along with their namespaces. It
+// returns the state of the profile, for the test to compare with the state of the plugins it runs.
+func createPluginStateFixtures(ctx context.Context, client ctrlclient.Client, path, profileName string) (*debug.ProfileState, error) {
+	snapshot, err := debug.ReadSnapshotFile(path)
+	if err != nil {
+		return nil, err
+	}
+	state, ok := snapshot.Profiles[profileName]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found in snapshot %s", profileName, path)
+	}
+
+	if state.NodeResourceTopology != nil {
+		for _, nrt := range state.NodeResourceTopology.NodeResourceTopologies {
+			nrt.ObjectMeta = metav1.ObjectMeta{Name: nrt.Name, Labels: nrt.Labels, Annotations: nrt.Annotations}
+			if err := client.Create(ctx, &nrt); err != nil && !apierrors.IsAlreadyExists(err) {
+				return nil, err
+			}
+		}
+	}
+	if state.Coscheduling != nil {
+		for _, pg := range state.Coscheduling.PodGroups {
+			pg.ObjectMeta = metav1.ObjectMeta{Namespace: pg.Namespace, Name: pg.Name, Labels: pg.Labels, Annotations: pg.Annotations}
+			if err := createNamespaced(ctx, client, &pg); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if state.CapacityScheduling != nil {
+		for _, eq := range state.CapacityScheduling.Quotas() {
+			if err := createNamespaced(ctx, client, eq); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return state, nil
+}
+
+// createNamespaced creates the object along with its namespace, unless they already exist.
+func createNamespaced(ctx context.Context, client ctrlclient.Client, obj ctrlclient.Object) error {
+	ns := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: obj.GetNamespace()}}
+	if err := client.Create(ctx, ns); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	if err := client.Create(ctx, obj); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
 func createAppGroups(ctx context.Context, client ctrlclient.Client, appGroups []*agv1alpha1.AppGroup) error {
 	for _, ag := range appGroups {
 		err := client.Create(ctx, ag.DeepCopy())